@@ -0,0 +1,165 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type BfdProfileClientContext utl.ClientContext
+
+func NewBfdProfilesClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *BfdProfileClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewBfdProfilesClient(connector)
+
+	case utl.Global:
+		client = client1.NewBfdProfilesClient(connector)
+
+	default:
+		return nil
+	}
+	return &BfdProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+}
+
+func (c BfdProfileClientContext) Get(bfdProfileIdParam string) (model0.BfdProfile, error) {
+	var obj model0.BfdProfile
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.BfdProfilesClient)
+		obj, err = client.Get(bfdProfileIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Global:
+		client := c.Client.(client1.BfdProfilesClient)
+		gmObj, err1 := client.Get(bfdProfileIdParam)
+		if err1 != nil {
+			return obj, err1
+		}
+		var rawObj interface{}
+		rawObj, err = utl.ConvertModelBindingType(gmObj, model1.BfdProfileBindingType(), model0.BfdProfileBindingType())
+		obj = rawObj.(model0.BfdProfile)
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c BfdProfileClientContext) Patch(bfdProfileIdParam string, bfdProfileParam model0.BfdProfile, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.BfdProfilesClient)
+		err = client.Patch(bfdProfileIdParam, bfdProfileParam, overrideParam)
+
+	case utl.Global:
+		client := c.Client.(client1.BfdProfilesClient)
+		gmObj, err1 := utl.ConvertModelBindingType(bfdProfileParam, model0.BfdProfileBindingType(), model1.BfdProfileBindingType())
+		if err1 != nil {
+			return err1
+		}
+		err = client.Patch(bfdProfileIdParam, gmObj.(model1.BfdProfile), overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c BfdProfileClientContext) Update(bfdProfileIdParam string, bfdProfileParam model0.BfdProfile, overrideParam *bool) (model0.BfdProfile, error) {
+	var err error
+	var obj model0.BfdProfile
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.BfdProfilesClient)
+		obj, err = client.Update(bfdProfileIdParam, bfdProfileParam, overrideParam)
+
+	case utl.Global:
+		client := c.Client.(client1.BfdProfilesClient)
+		gmObj, err := utl.ConvertModelBindingType(bfdProfileParam, model0.BfdProfileBindingType(), model1.BfdProfileBindingType())
+		if err != nil {
+			return obj, err
+		}
+		gmObj, err = client.Update(bfdProfileIdParam, gmObj.(model1.BfdProfile), overrideParam)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.BfdProfileBindingType(), model0.BfdProfileBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.BfdProfile)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c BfdProfileClientContext) Delete(bfdProfileIdParam string, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.BfdProfilesClient)
+		err = client.Delete(bfdProfileIdParam, overrideParam)
+
+	case utl.Global:
+		client := c.Client.(client1.BfdProfilesClient)
+		err = client.Delete(bfdProfileIdParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c BfdProfileClientContext) List(cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.BfdProfileListResult, error) {
+	var err error
+	var obj model0.BfdProfileListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.BfdProfilesClient)
+		obj, err = client.List(cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Global:
+		client := c.Client.(client1.BfdProfilesClient)
+		gmObj, err := client.List(cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.BfdProfileListResultBindingType(), model0.BfdProfileListResultBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.BfdProfileListResult)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -0,0 +1,156 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type PolicyDraftClientContext utl.ClientContext
+
+func NewDraftsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *PolicyDraftClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewDraftsClient(connector)
+
+	case utl.Multitenancy:
+		client = client1.NewDraftsClient(connector)
+
+	default:
+		return nil
+	}
+	return &PolicyDraftClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+}
+
+func (c PolicyDraftClientContext) Get(draftIdParam string) (model0.PolicyDraft, error) {
+	var obj model0.PolicyDraft
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.DraftsClient)
+		obj, err = client.Get(draftIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.DraftsClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, draftIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyDraftClientContext) Delete(draftIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.DraftsClient)
+		err = client.Delete(draftIdParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.DraftsClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, draftIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PolicyDraftClientContext) Patch(draftIdParam string, policyDraftParam model0.PolicyDraft) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.DraftsClient)
+		err = client.Patch(draftIdParam, policyDraftParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.DraftsClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, draftIdParam, policyDraftParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PolicyDraftClientContext) Update(draftIdParam string, policyDraftParam model0.PolicyDraft) (model0.PolicyDraft, error) {
+	var err error
+	var obj model0.PolicyDraft
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.DraftsClient)
+		obj, err = client.Update(draftIdParam, policyDraftParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.DraftsClient)
+		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, draftIdParam, policyDraftParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyDraftClientContext) List(autoDraftsParam *bool, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PolicyDraftListResult, error) {
+	var err error
+	var obj model0.PolicyDraftListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.DraftsClient)
+		obj, err = client.List(autoDraftsParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.DraftsClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, autoDraftsParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyDraftClientContext) Publish(draftIdParam string, infraParam model0.Infra) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.DraftsClient)
+		err = client.Publish(draftIdParam, infraParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.DraftsClient)
+		err = client.Publish(utl.DefaultOrgID, c.ProjectID, draftIdParam, infraParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+//nolint:revive
+package ports
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments/ports"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/segments/ports"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type PortSecurityProfileBindingMapClientContext utl.ClientContext
+
+func NewPortSecurityProfileBindingMapsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *PortSecurityProfileBindingMapClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewPortSecurityProfileBindingMapsClient(connector)
+
+	case utl.Multitenancy:
+		client = client1.NewPortSecurityProfileBindingMapsClient(connector)
+
+	default:
+		return nil
+	}
+	return &PortSecurityProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+}
+
+func (c PortSecurityProfileBindingMapClientContext) Get(segmentIdParam string, portIdParam string, portSecurityProfileBindingMapIdParam string) (model0.PortSecurityProfileBindingMap, error) {
+	var obj model0.PortSecurityProfileBindingMap
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortSecurityProfileBindingMapsClient)
+		obj, err = client.Get(segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortSecurityProfileBindingMapsClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PortSecurityProfileBindingMapClientContext) Delete(segmentIdParam string, portIdParam string, portSecurityProfileBindingMapIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortSecurityProfileBindingMapsClient)
+		err = client.Delete(segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortSecurityProfileBindingMapsClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PortSecurityProfileBindingMapClientContext) Patch(segmentIdParam string, portIdParam string, portSecurityProfileBindingMapIdParam string, portSecurityProfileBindingMapParam model0.PortSecurityProfileBindingMap) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortSecurityProfileBindingMapsClient)
+		err = client.Patch(segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam, portSecurityProfileBindingMapParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortSecurityProfileBindingMapsClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam, portSecurityProfileBindingMapParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PortSecurityProfileBindingMapClientContext) Update(segmentIdParam string, portIdParam string, portSecurityProfileBindingMapIdParam string, portSecurityProfileBindingMapParam model0.PortSecurityProfileBindingMap) (model0.PortSecurityProfileBindingMap, error) {
+	var err error
+	var obj model0.PortSecurityProfileBindingMap
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortSecurityProfileBindingMapsClient)
+		obj, err = client.Update(segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam, portSecurityProfileBindingMapParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortSecurityProfileBindingMapsClient)
+		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, portSecurityProfileBindingMapIdParam, portSecurityProfileBindingMapParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PortSecurityProfileBindingMapClientContext) List(segmentIdParam string, portIdParam string, cursorParam *string, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PortSecurityProfileBindingMapListResult, error) {
+	var err error
+	var obj model0.PortSecurityProfileBindingMapListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortSecurityProfileBindingMapsClient)
+		obj, err = client.List(segmentIdParam, portIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortSecurityProfileBindingMapsClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -15,9 +16,33 @@ import (
 
 func main() {
 	var debugMode bool
+	var exportMode bool
+	var exportOutput string
+	var exportOptions nsxt.ExportOptions
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&exportMode, "export", false, "set to true to export existing policy configuration as terraform resources with import blocks")
+	flag.StringVar(&exportOutput, "export-output", "", "file to write exported configuration to, defaults to stdout")
+	flag.StringVar(&exportOptions.ProjectID, "export-project", "", "export objects of this project")
+	flag.StringVar(&exportOptions.Domain, "export-domain", "", "only export objects under this domain")
+	flag.StringVar(&exportOptions.Tier1ID, "export-tier1", "", "only export this Tier1 gateway and objects under it")
 	flag.Parse()
 
+	if exportMode {
+		output := os.Stdout
+		if exportOutput != "" {
+			f, err := os.Create(exportOutput)
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+			defer f.Close()
+			output = f
+		}
+		if err := nsxt.ExportPolicyConfiguration(output, exportOptions); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := &plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return nsxt.Provider()
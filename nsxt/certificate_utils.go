@@ -0,0 +1,234 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Schema attribute names of certificate subject, with corresponding X509 keys
+var certificateSubjectAttributes = [][]string{
+	{"common_name", "CN"},
+	{"organization", "O"},
+	{"organization_unit", "OU"},
+	{"country", "C"},
+	{"state", "ST"},
+	{"locality", "L"},
+}
+
+func resourceNsxtPolicyCertificateExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCertificatesClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getCertificateSubjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Subject of the certificate",
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"common_name": {
+					Type:        schema.TypeString,
+					Description: "Common name (CN)",
+					Required:    true,
+					ForceNew:    true,
+				},
+				"organization": {
+					Type:        schema.TypeString,
+					Description: "Organization name (O)",
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"organization_unit": {
+					Type:        schema.TypeString,
+					Description: "Organization unit (OU)",
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"country": {
+					Type:         schema.TypeString,
+					Description:  "Two letter country code (C)",
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(2, 2),
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Description: "State or province name (ST)",
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"locality": {
+					Type:        schema.TypeString,
+					Description: "Locality name (L)",
+					Optional:    true,
+					ForceNew:    true,
+				},
+			},
+		},
+	}
+}
+
+// Attributes shared by resources that generate a key pair on NSX
+func getCertificateKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"subject": getCertificateSubjectSchema(),
+		"algorithm": {
+			Type:         schema.TypeString,
+			Description:  "Cryptographic algorithm used by the public key",
+			Optional:     true,
+			ForceNew:     true,
+			Default:      model.TlsCsr_ALGORITHM_RSA,
+			ValidateFunc: validation.StringInSlice([]string{model.TlsCsr_ALGORITHM_RSA}, false),
+		},
+		"key_size": {
+			Type:         schema.TypeInt,
+			Description:  "Size of the public key in bits",
+			Optional:     true,
+			ForceNew:     true,
+			Default:      2048,
+			ValidateFunc: validation.IntInSlice([]int{2048, 3072, 4096}),
+		},
+		"is_ca": {
+			Type:        schema.TypeBool,
+			Description: "Whether the certificate is a CA certificate",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+	}
+}
+
+func getCertificateSubjectFromSchema(d *schema.ResourceData) *model.Principal {
+	subjects := d.Get("subject").([]interface{})
+	if len(subjects) == 0 || subjects[0] == nil {
+		return nil
+	}
+
+	data := subjects[0].(map[string]interface{})
+	var attributes []model.KeyValue
+	for _, attr := range certificateSubjectAttributes {
+		value := data[attr[0]].(string)
+		if value == "" {
+			continue
+		}
+		key := attr[1]
+		attributes = append(attributes, model.KeyValue{
+			Key:   &key,
+			Value: &value,
+		})
+	}
+
+	return &model.Principal{Attributes: attributes}
+}
+
+func setCertificateSubjectInSchema(d *schema.ResourceData, subject *model.Principal) {
+	if subject == nil {
+		return
+	}
+
+	elem := make(map[string]interface{})
+	for _, attr := range certificateSubjectAttributes {
+		for _, kv := range subject.Attributes {
+			if kv.Key != nil && *kv.Key == attr[1] {
+				elem[attr[0]] = kv.Value
+			}
+		}
+	}
+
+	d.Set("subject", []interface{}{elem})
+}
+
+// Subject of X509 certificate is reported as distinguished name, for example "CN=test, O=Acme"
+func getCertificateSubjectFromDN(dn string) *model.Principal {
+	var attributes []model.KeyValue
+	for _, rdn := range strings.Split(dn, ",") {
+		pair := strings.SplitN(strings.TrimSpace(rdn), "=", 2)
+		if len(pair) != 2 {
+			continue
+		}
+		key := strings.ToUpper(strings.TrimSpace(pair[0]))
+		value := strings.TrimSpace(pair[1])
+		attributes = append(attributes, model.KeyValue{
+			Key:   &key,
+			Value: &value,
+		})
+	}
+
+	return &model.Principal{Attributes: attributes}
+}
+
+// Settings of key generated on NSX, as reflected in the resulting certificate
+func setSelfSignedCertificateKeyInSchema(d *schema.ResourceData, cert model.X509Certificate) {
+	d.Set("algorithm", cert.PublicKeyAlgo)
+	d.Set("key_size", cert.PublicKeyLength)
+	d.Set("is_ca", cert.IsCa)
+	if cert.NotBefore != nil && cert.NotAfter != nil {
+		d.Set("days_valid", (*cert.NotAfter-*cert.NotBefore)/(24*time.Hour).Milliseconds())
+	}
+	if cert.Subject != nil {
+		setCertificateSubjectInSchema(d, getCertificateSubjectFromDN(*cert.Subject))
+	}
+}
+
+// Computed attributes exposing details of X509 certificate
+func getCertificateDetailsSchema() map[string]*schema.Schema {
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"subject_cn":        computedString("Common name of certificate subject"),
+		"issuer_cn":         computedString("Common name of certificate issuer"),
+		"serial_number":     computedString("Certificate serial number"),
+		"sha256_thumbprint": computedString("SHA-256 thumbprint of the certificate"),
+		"not_before":        computedString("Start of certificate validity period, in RFC3339 format"),
+		"not_after":         computedString("Certificate expiry, in RFC3339 format"),
+	}
+}
+
+func addCertificateDetailsSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for key, value := range getCertificateDetailsSchema() {
+		s[key] = value
+	}
+	return s
+}
+
+// Details of the leaf certificate, which comes first in the chain, are exposed
+func setCertificateDetailsInSchema(d *schema.ResourceData, details []model.X509Certificate) {
+	if len(details) == 0 {
+		return
+	}
+	cert := details[0]
+
+	d.Set("subject_cn", cert.SubjectCn)
+	d.Set("issuer_cn", cert.IssuerCn)
+	d.Set("serial_number", cert.SerialNumber)
+	d.Set("sha256_thumbprint", cert.Sha256Thumbprint)
+	d.Set("not_before", policyTimestampToString(cert.NotBefore))
+	d.Set("not_after", policyTimestampToString(cert.NotAfter))
+}
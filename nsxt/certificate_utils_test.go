@@ -0,0 +1,27 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+)
+
+func TestGetCertificateSubjectFromDN(t *testing.T) {
+	subject := getCertificateSubjectFromDN("CN=test.example.com, O=Acme Co,ou=IT, C=US, invalid")
+
+	expected := map[string]string{
+		"CN": "test.example.com",
+		"O":  "Acme Co",
+		"OU": "IT",
+		"C":  "US",
+	}
+	if len(subject.Attributes) != len(expected) {
+		t.Fatalf("expected %d attributes, got %d", len(expected), len(subject.Attributes))
+	}
+	for _, kv := range subject.Attributes {
+		if expected[*kv.Key] != *kv.Value {
+			t.Errorf("unexpected value %s for %s", *kv.Value, *kv.Key)
+		}
+	}
+}
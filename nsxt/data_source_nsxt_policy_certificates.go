@@ -0,0 +1,343 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/trust_management"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	certificateUsageAny    = "ANY"
+	certificateUsageInUse  = "IN_USE"
+	certificateUsageUnused = "UNUSED"
+)
+
+var certificateUsageValues = []string{
+	certificateUsageAny,
+	certificateUsageInUse,
+	certificateUsageUnused,
+}
+
+// Resource type reported for certificate usage by principal identity
+const certificateUsedByPrincipalIdentity = "PrincipalIdentity"
+
+func dataSourceNsxtPolicyCertificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyCertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"expiring_within_days": {
+				Type:         schema.TypeInt,
+				Description:  "Only return certificates that expire within given number of days, including expired certificates",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"usage": {
+				Type:         schema.TypeString,
+				Description:  "Filter certificates by usage",
+				Optional:     true,
+				Default:      certificateUsageAny,
+				ValidateFunc: validation.StringInSlice(certificateUsageValues, false),
+			},
+			"used_by_type": {
+				Type:        schema.TypeString,
+				Description: "Only return certificates used by objects of given resource type",
+				Optional:    true,
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "List of certificates",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: addCertificateDetailsSchema(map[string]*schema.Schema{
+						"id":               getDataSourceStringSchema("ID of the certificate"),
+						"path":             getDataSourceStringSchema("Policy path of the certificate"),
+						"display_name":     getDataSourceStringSchema("Display name of the certificate"),
+						"description":      getDataSourceStringSchema("Description of the certificate"),
+						"certificate_type": getDataSourceStringSchema("Type of the certificate"),
+						"purpose":          getDataSourceStringSchema("Purpose of the certificate"),
+						"has_private_key": {
+							Type:        schema.TypeBool,
+							Description: "Whether private key is stored with this certificate",
+							Computed:    true,
+						},
+						"subject":       getDataSourceStringSchema("Subject of the certificate"),
+						"issuer":        getDataSourceStringSchema("Issuer of the certificate"),
+						"key_algorithm": getDataSourceStringSchema("Algorithm of the public key"),
+						"key_size": {
+							Type:        schema.TypeInt,
+							Description: "Size of the public key in bits",
+							Computed:    true,
+						},
+						"subject_alternative_names": {
+							Type:        schema.TypeList,
+							Description: "Subject alternative names of the certificate",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"days_to_expiry": {
+							Type:        schema.TypeInt,
+							Description: "Number of days until certificate expiry, negative for expired certificate",
+							Computed:    true,
+						},
+						"used_by": {
+							Type:        schema.TypeList,
+							Description: "Objects that use this certificate",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_type": getDataSourceStringSchema("Type of the object, or NSX Manager service type"),
+									"path":          getDataSourceStringSchema("Policy path of the object, if applicable"),
+									"id":            getDataSourceStringSchema("ID of the object or manager node"),
+								},
+							},
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func listPolicyCertificates(connector client.Connector) ([]model.TlsCertificate, error) {
+	client := infra.NewCertificatesClient(connector)
+
+	var results []model.TlsCertificate
+	details := true
+	var cursor *string
+	total := 0
+
+	for {
+		certificates, err := client.List(cursor, &details, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, certificates.Results...)
+		if total == 0 && certificates.ResultCount != nil {
+			// first response
+			total = int(*certificates.ResultCount)
+		}
+
+		cursor = certificates.Cursor
+		if len(results) >= total || cursor == nil {
+			return results, nil
+		}
+	}
+}
+
+// Usage of certificates by NSX Manager services and principal identities is only
+// reported by MP API, mapped by certificate ID
+func listCertificateManagerUsage(connector client.Connector) (map[string][]interface{}, error) {
+	usage := make(map[string][]interface{})
+
+	client := trust_management.NewCertificatesClient(connector)
+	var cursor *string
+	count := 0
+	total := 0
+	for {
+		certificates, err := client.List(cursor, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return usage, err
+		}
+		for _, cert := range certificates.Results {
+			if cert.Id == nil {
+				continue
+			}
+			for _, usedBy := range cert.UsedBy {
+				for _, serviceType := range usedBy.ServiceTypes {
+					elem := make(map[string]interface{})
+					elem["resource_type"] = serviceType
+					elem["id"] = usedBy.NodeId
+					usage[*cert.Id] = append(usage[*cert.Id], elem)
+				}
+			}
+		}
+		count += len(certificates.Results)
+		if total == 0 && certificates.ResultCount != nil {
+			// first response
+			total = int(*certificates.ResultCount)
+		}
+
+		cursor = certificates.Cursor
+		if count >= total || cursor == nil {
+			break
+		}
+	}
+
+	piClient := trust_management.NewPrincipalIdentitiesClient(connector)
+	identities, err := piClient.List()
+	if err != nil {
+		return usage, err
+	}
+	for _, identity := range identities.Results {
+		if identity.CertificateId == nil {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["resource_type"] = certificateUsedByPrincipalIdentity
+		elem["id"] = identity.Id
+		usage[*identity.CertificateId] = append(usage[*identity.CertificateId], elem)
+	}
+
+	return usage, nil
+}
+
+func getCertificateSubjectAlternativeNames(pemEncoded *string) []string {
+	var names []string
+	if pemEncoded == nil {
+		return names
+	}
+
+	// Leaf certificate comes first in the chain
+	block, _ := pem.Decode([]byte(*pemEncoded))
+	if block == nil {
+		return names
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.Printf("[WARNING] Failed to parse certificate: %v", err)
+		return names
+	}
+
+	names = append(names, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	names = append(names, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+
+	return names
+}
+
+func getCertificateDaysToExpiry(notAfter *int64) int {
+	if notAfter == nil {
+		return 0
+	}
+	return int(math.Floor(time.Until(time.UnixMilli(*notAfter)).Hours() / 24))
+}
+
+func dataSourceNsxtPolicyCertificatesRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	expiringWithinDays, expiryFilter := d.GetOk("expiring_within_days")
+	usageFilter := d.Get("usage").(string)
+	usedByType := d.Get("used_by_type").(string)
+
+	certificates, err := listPolicyCertificates(connector)
+	if err != nil {
+		return fmt.Errorf("Error reading Certificates: %v", err)
+	}
+
+	managerUsage, err := listCertificateManagerUsage(connector)
+	if err != nil {
+		return fmt.Errorf("Error reading Certificate usage: %v", err)
+	}
+
+	// Usage is only relevant for certificates that passed expiry filter
+	var filtered []model.TlsCertificate
+	var paths []string
+	for _, cert := range certificates {
+		if cert.Id == nil || cert.Path == nil {
+			continue
+		}
+
+		var notAfter *int64
+		if len(cert.Details) > 0 {
+			notAfter = cert.Details[0].NotAfter
+		}
+		if expiryFilter && (notAfter == nil || getCertificateDaysToExpiry(notAfter) > expiringWithinDays.(int)) {
+			continue
+		}
+		filtered = append(filtered, cert)
+		paths = append(paths, *cert.Path)
+	}
+
+	// Referrers of all certificates are retrieved at once, since search is costly
+	referrers, err := listPolicyResourcesReferrers(connector, getSessionContext(d, m), paths)
+	if err != nil {
+		return fmt.Errorf("Error reading usage of Certificates: %v", err)
+	}
+
+	var items []interface{}
+	for _, cert := range filtered {
+		elem := make(map[string]interface{})
+		elem["days_to_expiry"] = getCertificateDaysToExpiry(nil)
+		if len(cert.Details) > 0 {
+			details := cert.Details[0]
+			elem["days_to_expiry"] = getCertificateDaysToExpiry(details.NotAfter)
+			elem["subject"] = details.Subject
+			elem["subject_cn"] = details.SubjectCn
+			elem["issuer"] = details.Issuer
+			elem["issuer_cn"] = details.IssuerCn
+			elem["serial_number"] = details.SerialNumber
+			elem["sha256_thumbprint"] = details.Sha256Thumbprint
+			elem["not_before"] = policyTimestampToString(details.NotBefore)
+			elem["not_after"] = policyTimestampToString(details.NotAfter)
+			elem["key_algorithm"] = details.PublicKeyAlgo
+			elem["key_size"] = details.PublicKeyLength
+		}
+
+		usedBy := managerUsage[*cert.Id]
+		for _, referrer := range referrers[*cert.Path] {
+			usageElem := make(map[string]interface{})
+			usageElem["resource_type"] = referrer.ResourceType
+			usageElem["path"] = referrer.Path
+			usageElem["id"] = getPolicyIDFromPath(referrer.Path)
+			usedBy = append(usedBy, usageElem)
+		}
+
+		if usageFilter == certificateUsageInUse && len(usedBy) == 0 {
+			continue
+		}
+		if usageFilter == certificateUsageUnused && len(usedBy) > 0 {
+			continue
+		}
+		if usedByType != "" {
+			found := false
+			for _, usageElem := range usedBy {
+				if usageElem.(map[string]interface{})["resource_type"] == usedByType {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		elem["id"] = cert.Id
+		elem["path"] = cert.Path
+		elem["display_name"] = cert.DisplayName
+		elem["description"] = cert.Description
+		elem["certificate_type"] = cert.TlsCertificateType
+		elem["purpose"] = cert.Purpose
+		elem["has_private_key"] = cert.HasPrivateKey
+		elem["subject_alternative_names"] = getCertificateSubjectAlternativeNames(cert.PemEncoded)
+		elem["used_by"] = usedBy
+
+		items = append(items, elem)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("items", items)
+}
@@ -0,0 +1,76 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyCertificates_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_certificates.test"
+	certPem, keyPem, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				// Test certificate expires in 180 days
+				Config: testAccNsxtPolicyCertificatesReadTemplate(name, certPem, keyPem, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(testResourceName, "items.*", map[string]string{
+						"display_name":    name,
+						"has_private_key": "true",
+						"used_by.#":       "0",
+					}),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCertificatesReadTemplate(name, certPem, keyPem, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					testAccNsxtPolicyCertificatesNotFound(testResourceName, name),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCertificatesNotFound(resourceName string, displayName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Data source %s not found in resources", resourceName)
+		}
+
+		for key, value := range rs.Primary.Attributes {
+			if value == displayName {
+				return fmt.Errorf("Certificate %s unexpectedly found in %s", displayName, key)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCertificatesReadTemplate(name string, certPem string, keyPem string, days int) string {
+	return testAccNsxtPolicyCertificateTemplate(name, certPem, keyPem) + fmt.Sprintf(`
+data "nsxt_policy_certificates" "test" {
+  expiring_within_days = %d
+  usage                = "UNUSED"
+
+  depends_on = [nsxt_policy_certificate.test]
+}`, days)
+}
@@ -0,0 +1,147 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+const (
+	firewallDraftTypeAny    = "ANY"
+	firewallDraftTypeAuto   = "AUTO"
+	firewallDraftTypeManual = "MANUAL"
+)
+
+var firewallDraftTypeValues = []string{
+	firewallDraftTypeAny,
+	firewallDraftTypeAuto,
+	firewallDraftTypeManual,
+}
+
+func dataSourceNsxtPolicyFirewallDrafts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyFirewallDraftsRead,
+
+		Schema: map[string]*schema.Schema{
+			"context": getContextSchema(),
+			"draft_type": {
+				Type:         schema.TypeString,
+				Description:  "Filter drafts by type",
+				Optional:     true,
+				Default:      firewallDraftTypeAny,
+				ValidateFunc: validation.StringInSlice(firewallDraftTypeValues, false),
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "List of firewall drafts",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":           getDataSourceStringSchema("ID of the draft"),
+						"path":         getDataSourceStringSchema("Policy path of the draft"),
+						"display_name": getDataSourceStringSchema("Display name of the draft"),
+						"description":  getDataSourceStringSchema("Description of the draft"),
+						"is_auto_draft": {
+							Type:        schema.TypeBool,
+							Description: "Whether the draft was automatically created by NSX",
+							Computed:    true,
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Description: "Whether the draft is locked",
+							Computed:    true,
+						},
+						"lock_comments":      getDataSourceStringSchema("Comments for the draft lock or unlock"),
+						"lock_modified_by":   getDataSourceStringSchema("ID of the user who last modified the lock"),
+						"lock_modified_time": getDataSourceStringSchema("Time the lock was last modified"),
+						"ref_draft_path":     getDataSourceStringSchema("Policy path of the draft this draft is based on"),
+						"create_time":        getDataSourceStringSchema("Creation time of the draft"),
+						"create_user":        getDataSourceStringSchema("ID of the user who created the draft"),
+						"last_modified_time": getDataSourceStringSchema("Last modification time of the draft"),
+						"last_modified_user": getDataSourceStringSchema("ID of the user who last modified the draft"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyFirewallDrafts(connector client.Connector, context utl.SessionContext, autoDrafts *bool) ([]model.PolicyDraft, error) {
+	client := infra.NewDraftsClient(context, connector)
+
+	var results []model.PolicyDraft
+	var cursor *string
+	total := 0
+
+	for {
+		drafts, err := client.List(autoDrafts, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, drafts.Results...)
+		if total == 0 && drafts.ResultCount != nil {
+			// first response
+			total = int(*drafts.ResultCount)
+		}
+
+		cursor = drafts.Cursor
+		if len(results) >= total || cursor == nil {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyFirewallDraftsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	var autoDrafts *bool
+	switch d.Get("draft_type").(string) {
+	case firewallDraftTypeAuto:
+		value := true
+		autoDrafts = &value
+	case firewallDraftTypeManual:
+		value := false
+		autoDrafts = &value
+	}
+
+	drafts, err := listPolicyFirewallDrafts(connector, getSessionContext(d, m), autoDrafts)
+	if err != nil {
+		return fmt.Errorf("Error reading Firewall Drafts: %v", err)
+	}
+
+	var items []interface{}
+	for _, draft := range drafts {
+		elem := make(map[string]interface{})
+		elem["id"] = draft.Id
+		elem["path"] = draft.Path
+		elem["display_name"] = draft.DisplayName
+		elem["description"] = draft.Description
+		elem["is_auto_draft"] = draft.IsAutoDraft
+		elem["locked"] = draft.Locked
+		elem["lock_comments"] = draft.LockComments
+		elem["lock_modified_by"] = draft.LockModifiedBy
+		elem["lock_modified_time"] = policyTimestampToString(draft.LockModifiedTime)
+		elem["ref_draft_path"] = draft.RefDraftPath
+		elem["create_time"] = policyTimestampToString(draft.CreateTime)
+		elem["create_user"] = draft.CreateUser
+		elem["last_modified_time"] = policyTimestampToString(draft.LastModifiedTime)
+		elem["last_modified_user"] = draft.LastModifiedUser
+
+		items = append(items, elem)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("items", items)
+}
@@ -0,0 +1,50 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyFirewallDrafts_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_firewall_drafts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(testResourceName, "items.*", map[string]string{
+						"display_name":  name,
+						"is_auto_draft": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallDraftsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_firewall_drafts" "test" {
+  draft_type = "MANUAL"
+
+  depends_on = [nsxt_policy_firewall_draft.test]
+}`, name)
+}
@@ -0,0 +1,116 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_pools"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbPoolStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbPoolStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"pool_path":              getPolicyPathSchema(true, false, "Policy path of the LB pool"),
+			"service_path":           getPolicyPathSchema(true, false, "Policy path of the LB service where the pool is used"),
+			"enforcement_point_path": getPolicyLbEnforcementPointPathSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the pool",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the data was last updated",
+				Computed:    true,
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Status of pool members",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "Pool member IP address",
+							Computed:    true,
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Description: "Pool member port",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Pool member status",
+							Computed:    true,
+						},
+						"failure_cause": {
+							Type:        schema.TypeString,
+							Description: "The healthcheck failure cause when status is DOWN",
+							Computed:    true,
+						},
+						"last_check_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp when the monitor status was last checked",
+							Computed:    true,
+						},
+						"last_state_change_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp when the monitor status was last changed",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLbPoolStatusRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := lb_pools.NewDetailedStatusClient(connector)
+
+	poolPath := d.Get("pool_path").(string)
+	servicePath := d.Get("service_path").(string)
+	enforcementPointPath := getPolicyLbEnforcementPointPathFromSchema(d, m)
+
+	aggregateStatus, err := client.Get(getPolicyIDFromPath(servicePath), getPolicyIDFromPath(poolPath), &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBPoolStatus", poolPath, err)
+	}
+
+	obj, err := getPolicyLbStatusResult(aggregateStatus.Results, model.LBPoolStatusBindingType(), poolPath, enforcementPointPath)
+	if err != nil {
+		return err
+	}
+	status := obj.(model.LBPoolStatus)
+
+	var memberList []interface{}
+	for _, member := range status.Members {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = member.IpAddress
+		elem["port"] = member.Port
+		elem["status"] = member.Status
+		elem["failure_cause"] = member.FailureCause
+		if member.LastCheckTime != nil {
+			elem["last_check_time"] = int(*member.LastCheckTime)
+		}
+		if member.LastStateChangeTime != nil {
+			elem["last_state_change_time"] = int(*member.LastStateChangeTime)
+		}
+		memberList = append(memberList, elem)
+	}
+
+	d.SetId(poolPath)
+	d.Set("enforcement_point_path", enforcementPointPath)
+	d.Set("status", status.Status)
+	d.Set("last_update_timestamp", status.LastUpdateTimestamp)
+	d.Set("member", memberList)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBPoolStatus_basic(t *testing.T) {
+	name := getTestLBServiceName()
+	testResourceName := "data.nsxt_policy_lb_pool_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_LB_SERVICE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolStatusReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_policy_lb_pool.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.ip_address", "5.5.5.5"),
+					resource.TestCheckResourceAttrSet(testResourceName, "member.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPoolStatusReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_service" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_lb_app_profile" "default_tcp" {
+  type         = "TCP"
+  display_name = "default-tcp-lb-app-profile"
+}
+
+resource "nsxt_policy_lb_pool" "test" {
+  display_name = "%s"
+
+  member {
+    ip_address = "5.5.5.5"
+    port       = "80"
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_tcp.path
+  ip_address               = "1.1.1.11"
+  ports                    = ["8080"]
+  service_path             = data.nsxt_policy_lb_service.test.path
+  pool_path                = nsxt_policy_lb_pool.test.path
+}
+
+data "nsxt_policy_lb_pool_status" "test" {
+  pool_path    = nsxt_policy_lb_pool.test.path
+  service_path = nsxt_policy_lb_virtual_server.test.service_path
+}`, name, getAccTestResourceName(), getAccTestResourceName())
+}
@@ -0,0 +1,95 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbServiceStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbServiceStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"service_path":           getPolicyPathSchema(true, false, "Policy path of the LB service"),
+			"enforcement_point_path": getPolicyLbEnforcementPointPathSchema(),
+			"service_status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the LB service",
+				Computed:    true,
+			},
+			"active_transport_nodes": {
+				Type:        schema.TypeList,
+				Description: "IDs of edge transport nodes where LB service is active",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"standby_transport_nodes": {
+				Type:        schema.TypeList,
+				Description: "IDs of edge transport nodes where LB service is in standby",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cpu_usage": {
+				Type:        schema.TypeInt,
+				Description: "CPU usage of the LB service in percent",
+				Computed:    true,
+			},
+			"memory_usage": {
+				Type:        schema.TypeInt,
+				Description: "Memory usage of the LB service in percent",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "Error message, if available",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the data was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLbServiceStatusRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := lb_services.NewDetailedStatusClient(connector)
+
+	servicePath := d.Get("service_path").(string)
+	enforcementPointPath := getPolicyLbEnforcementPointPathFromSchema(d, m)
+	includeInstanceDetails := false
+
+	aggregateStatus, err := client.Get(getPolicyIDFromPath(servicePath), &enforcementPointPath, &includeInstanceDetails, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBServiceStatus", servicePath, err)
+	}
+
+	obj, err := getPolicyLbStatusResult(aggregateStatus.Results, model.LBServiceStatusBindingType(), servicePath, enforcementPointPath)
+	if err != nil {
+		return err
+	}
+	status := obj.(model.LBServiceStatus)
+
+	d.SetId(servicePath)
+	d.Set("enforcement_point_path", enforcementPointPath)
+	d.Set("service_status", status.ServiceStatus)
+	d.Set("active_transport_nodes", status.ActiveTransportNodes)
+	d.Set("standby_transport_nodes", status.StandbyTransportNodes)
+	d.Set("cpu_usage", status.CpuUsage)
+	d.Set("memory_usage", status.MemoryUsage)
+	d.Set("error_message", status.ErrorMessage)
+	d.Set("last_update_timestamp", status.LastUpdateTimestamp)
+
+	return nil
+}
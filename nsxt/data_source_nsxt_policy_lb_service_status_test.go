@@ -0,0 +1,46 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBServiceStatus_basic(t *testing.T) {
+	name := getTestLBServiceName()
+	testResourceName := "data.nsxt_policy_lb_service_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_LB_SERVICE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBServiceStatusReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "data.nsxt_policy_lb_service.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "service_status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBServiceStatusReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_service" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_lb_service_status" "test" {
+  service_path = data.nsxt_policy_lb_service.test.path
+}`, name)
+}
@@ -0,0 +1,172 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_virtual_servers"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbVirtualServerStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbVirtualServerStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"virtual_server_path":    getPolicyPathSchema(true, false, "Policy path of the LB virtual server"),
+			"enforcement_point_path": getPolicyLbEnforcementPointPathSchema(),
+			"service_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the LB service the virtual server is attached to. If not specified, service path configured on the virtual server is used",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the virtual server",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the data was last updated",
+				Computed:    true,
+			},
+			"statistics": {
+				Type:        schema.TypeList,
+				Description: "Virtual server statistics",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_in":                          getLbStatisticsCounterSchema(schema.TypeInt, "Number of bytes in"),
+						"bytes_in_rate":                     getLbStatisticsCounterSchema(schema.TypeFloat, "The average number of inbound bytes per second"),
+						"bytes_out":                         getLbStatisticsCounterSchema(schema.TypeInt, "Number of bytes out"),
+						"bytes_out_rate":                    getLbStatisticsCounterSchema(schema.TypeFloat, "The average number of outbound bytes per second"),
+						"current_session_rate":              getLbStatisticsCounterSchema(schema.TypeFloat, "The average number of current sessions per second"),
+						"current_sessions":                  getLbStatisticsCounterSchema(schema.TypeInt, "Number of current sessions"),
+						"dropped_packets_by_access_list":    getLbStatisticsCounterSchema(schema.TypeInt, "Number of packets dropped by access list control"),
+						"dropped_sessions_by_lbrule_action": getLbStatisticsCounterSchema(schema.TypeInt, "Number of sessions dropped by LB rule action"),
+						"http_request_rate":                 getLbStatisticsCounterSchema(schema.TypeFloat, "The average number of http requests per second"),
+						"http_requests":                     getLbStatisticsCounterSchema(schema.TypeInt, "The total number of http requests"),
+						"max_sessions":                      getLbStatisticsCounterSchema(schema.TypeInt, "Number of maximum sessions"),
+						"packets_in":                        getLbStatisticsCounterSchema(schema.TypeInt, "Number of packets in"),
+						"packets_out":                       getLbStatisticsCounterSchema(schema.TypeInt, "Number of packets out"),
+						"source_ip_persistence_entry_size":  getLbStatisticsCounterSchema(schema.TypeInt, "Number of source IP persistence entries"),
+						"total_sessions":                    getLbStatisticsCounterSchema(schema.TypeInt, "Number of total sessions"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func getLbStatisticsCounterSchema(valueType schema.ValueType, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        valueType,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+func getLbStatisticsCounterValues(counter *model.LBStatisticsCounter) []interface{} {
+	if counter == nil {
+		return nil
+	}
+
+	elem := make(map[string]interface{})
+	elem["bytes_in"] = counter.BytesIn
+	elem["bytes_in_rate"] = counter.BytesInRate
+	elem["bytes_out"] = counter.BytesOut
+	elem["bytes_out_rate"] = counter.BytesOutRate
+	elem["current_session_rate"] = counter.CurrentSessionRate
+	elem["current_sessions"] = counter.CurrentSessions
+	elem["dropped_packets_by_access_list"] = counter.DroppedPacketsByAccessList
+	elem["dropped_sessions_by_lbrule_action"] = counter.DroppedSessionsByLbruleAction
+	elem["http_request_rate"] = counter.HttpRequestRate
+	elem["http_requests"] = counter.HttpRequests
+	elem["max_sessions"] = counter.MaxSessions
+	elem["packets_in"] = counter.PacketsIn
+	elem["packets_out"] = counter.PacketsOut
+	elem["source_ip_persistence_entry_size"] = counter.SourceIpPersistenceEntrySize
+	elem["total_sessions"] = counter.TotalSessions
+
+	// Counters are optional in the API, drop unset values
+	for key, value := range elem {
+		switch v := value.(type) {
+		case *int64:
+			if v == nil {
+				delete(elem, key)
+			} else {
+				elem[key] = int(*v)
+			}
+		case *float64:
+			if v == nil {
+				delete(elem, key)
+			} else {
+				elem[key] = *v
+			}
+		}
+	}
+
+	return []interface{}{elem}
+}
+
+func dataSourceNsxtPolicyLbVirtualServerStatusRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	vsPath := d.Get("virtual_server_path").(string)
+	vsID := getPolicyIDFromPath(vsPath)
+	enforcementPointPath := getPolicyLbEnforcementPointPathFromSchema(d, m)
+
+	servicePath := d.Get("service_path").(string)
+	if servicePath == "" {
+		vsClient := infra.NewLbVirtualServersClient(connector)
+		vs, err := vsClient.Get(vsID)
+		if err != nil {
+			return handleDataSourceReadError(d, "LBVirtualServer", vsPath, err)
+		}
+		if vs.LbServicePath == nil || *vs.LbServicePath == "" {
+			return fmt.Errorf("LB virtual server %s is not attached to LB service", vsPath)
+		}
+		servicePath = *vs.LbServicePath
+	}
+	serviceID := getPolicyIDFromPath(servicePath)
+
+	statusClient := lb_virtual_servers.NewDetailedStatusClient(connector)
+	aggregateStatus, err := statusClient.Get(serviceID, vsID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBVirtualServerStatus", vsPath, err)
+	}
+
+	obj, err := getPolicyLbStatusResult(aggregateStatus.Results, model.LBVirtualServerStatusBindingType(), vsPath, enforcementPointPath)
+	if err != nil {
+		return err
+	}
+	status := obj.(model.LBVirtualServerStatus)
+
+	statisticsClient := lb_virtual_servers.NewStatisticsClient(connector)
+	aggregateStatistics, err := statisticsClient.Get(serviceID, vsID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBVirtualServerStatistics", vsPath, err)
+	}
+
+	obj, err = getPolicyLbStatusResult(aggregateStatistics.Results, model.LBVirtualServerStatisticsBindingType(), vsPath, enforcementPointPath)
+	if err != nil {
+		return err
+	}
+	statistics := obj.(model.LBVirtualServerStatistics)
+
+	d.SetId(vsPath)
+	d.Set("service_path", servicePath)
+	d.Set("enforcement_point_path", enforcementPointPath)
+	d.Set("status", status.Status)
+	d.Set("last_update_timestamp", status.LastUpdateTimestamp)
+	d.Set("statistics", getLbStatisticsCounterValues(statistics.Statistics))
+
+	return nil
+}
@@ -0,0 +1,60 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBVirtualServerStatus_basic(t *testing.T) {
+	name := getTestLBServiceName()
+	testResourceName := "data.nsxt_policy_lb_virtual_server_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_LB_SERVICE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerStatusReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "service_path", "data.nsxt_policy_lb_service.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttr(testResourceName, "statistics.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "statistics.0.total_sessions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBVirtualServerStatusReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_service" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_lb_app_profile" "default_tcp" {
+  type         = "TCP"
+  display_name = "default-tcp-lb-app-profile"
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_tcp.path
+  ip_address               = "1.1.1.10"
+  ports                    = ["8080"]
+  service_path             = data.nsxt_policy_lb_service.test.path
+}
+
+data "nsxt_policy_lb_virtual_server_status" "test" {
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+}`, name, getAccTestResourceName())
+}
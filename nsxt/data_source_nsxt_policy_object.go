@@ -0,0 +1,61 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"context": getContextSchema(),
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the object",
+				Computed:    true,
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Description: "JSON representation of the object",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyObjectRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	path := d.Get("path").(string)
+
+	obj, err := policyGenericGet(connector, getSessionContext(d, m), path)
+	if err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("Policy object with path %s was not found", path)
+		}
+		return handleDataSourceReadError(d, "Policy Object", path, err)
+	}
+
+	bodyBytes, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+	d.Set("display_name", obj["display_name"])
+	d.Set("body", string(bodyBytes))
+
+	return nil
+}
@@ -0,0 +1,96 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentPort() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentPortRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"context":      getContextSchema(),
+			"segment_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the parent segment",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"vif_id": {
+				Type:          schema.TypeString,
+				Description:   "VIF attachment ID of the port",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vm_id"},
+			},
+			"vm_id": {
+				Type:          schema.TypeString,
+				Description:   "External ID of the VM the port is attached to",
+				Optional:      true,
+				ConflictsWith: []string{"vif_id"},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentPortRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	vifID := d.Get("vif_id").(string)
+	vmID := d.Get("vm_id").(string)
+
+	query := make(map[string]string)
+	if segmentPath != "" {
+		query["parent_path"] = segmentPath
+	}
+	if vifID != "" {
+		query["attachment.id"] = vifID
+	}
+	if vmID != "" {
+		vifAttachmentIds, err := listPolicyVifAttachmentsForVM(m, vmID)
+		if err != nil {
+			return err
+		}
+		if len(vifAttachmentIds) == 0 {
+			return fmt.Errorf("No VIF attachments found for VM %s", vmID)
+		}
+		query["attachment.id"] = fmt.Sprintf("(%s)", strings.Join(vifAttachmentIds, " OR "))
+	}
+	if len(query) == 0 && d.Get("id").(string) == "" && d.Get("display_name").(string) == "" {
+		return fmt.Errorf("No 'id', 'display_name', 'segment_path', 'vif_id' or 'vm_id' specified for SegmentPort")
+	}
+
+	obj, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), getSessionContext(d, m), "SegmentPort", query, false)
+	if err != nil {
+		return err
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToGolang(obj, model.SegmentPortBindingType())
+	if len(errors) > 0 {
+		return errors[0]
+	}
+	segmentPort := dataValue.(model.SegmentPort)
+	d.Set("segment_path", segmentPort.ParentPath)
+	if segmentPort.Attachment != nil {
+		d.Set("vif_id", segmentPort.Attachment.Id)
+	}
+
+	return nil
+}
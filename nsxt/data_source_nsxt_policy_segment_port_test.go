@@ -0,0 +1,42 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentPort_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_port.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySegmentPortCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySegmentPortCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "vif_id", accTestPolicySegmentPortCreateAttributes["vif_id"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", "nsxt_policy_segment_port.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "segment_path", "nsxt_policy_segment.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentPortReadTemplate() string {
+	return testAccNsxtPolicySegmentPortTemplate(true, false, false) + fmt.Sprintf(`
+
+data "nsxt_policy_segment_port" "test" {
+  vif_id = "%s"
+
+  depends_on = [nsxt_policy_segment_port.test]
+}`, accTestPolicySegmentPortCreateAttributes["vif_id"])
+}
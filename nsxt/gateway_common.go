@@ -685,3 +685,39 @@ func policyTier0GetLocaleService(gwID string, localeServiceID string, connector
 	}
 	return &obj
 }
+
+// Returns path of Tier0 locale service, which serves as lock key for
+// operations on the locale service and its children
+func getPolicyTier0LocaleServiceLockPath(context utl.SessionContext, gwID string, localeServiceID string) string {
+	return getPolicyLocaleServiceLockPath(fmt.Sprintf("%s/tier-0s/%s", getPolicyInfraPath(context), gwID), localeServiceID)
+}
+
+func getPolicyLocaleServiceLockPath(gwPath string, localeServiceID string) string {
+	return fmt.Sprintf("%s/locale-services/%s", gwPath, localeServiceID)
+}
+
+// Returns lock paths for locale services that gateway configuration may modify:
+// the default locale service, and services listed in locale_service blocks
+// before and after the change
+func getPolicyGatewayLocaleServiceLockPaths(d *schema.ResourceData, gwPath string) []string {
+	serviceIDs := map[string]bool{defaultPolicyLocaleServiceID: true}
+	oldServices, newServices := d.GetChange("locale_service")
+	for _, services := range []interface{}{oldServices, newServices} {
+		serviceSet, ok := services.(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, service := range serviceSet.List() {
+			nsxID := service.(map[string]interface{})["nsx_id"].(string)
+			if nsxID != "" {
+				serviceIDs[nsxID] = true
+			}
+		}
+	}
+
+	var lockPaths []string
+	for serviceID := range serviceIDs {
+		lockPaths = append(lockPaths, getPolicyLocaleServiceLockPath(gwPath, serviceID))
+	}
+	return lockPaths
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -300,6 +302,63 @@ func resourceNsxtPolicyLBMonitorProfileDelete(d *schema.ResourceData, m interfac
 	return nil
 }
 
+func resourceNsxtPolicyLBPersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+	msg := "Error retrieving resource LBPersistenceProfile"
+	return false, logAPIError(msg, err)
+}
+
+func resourceNsxtPolicyLBPersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPersistenceProfile ID")
+	}
+	connector := getPolicyConnector(m)
+	forceParam := true
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	err := client.Delete(id, &forceParam)
+	if err != nil {
+		return handleDeleteError("LBPersistenceProfile", id, err)
+	}
+	return nil
+}
+
+func getLbPersistenceSharedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether all virtual servers that consume this profile should share the same persistence mechanism",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func getLbHaPersistenceMirroringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether persistence entries will be synchronized to the HA peer",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func getLbPersistenceTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Persistence expiration time in seconds, counted from the time all the connections are completed",
+		Optional:     true,
+		Default:      300,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
 func getLbServerSslSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -388,3 +447,55 @@ func setLbServerSslInSchema(d *schema.ResourceData, lBServerSsl model.LBServerSs
 		log.Printf("[WARNING] Failed to set server_ssl in schema: %v", err)
 	}
 }
+
+func getPolicyLbStatusResultEnforcementPoint(result *data.StructValue) string {
+	field, err := result.Field("enforcement_point_path")
+	if err != nil {
+		return ""
+	}
+	if optional, ok := field.(*data.OptionalValue); ok {
+		field = optional.Value()
+	}
+	if value, ok := field.(*data.StringValue); ok {
+		return value.Value()
+	}
+	return ""
+}
+
+// LB status and statistics APIs return polymorphic per-enforcement point results.
+// Convert the first result of requested enforcement point that matches requested type.
+func getPolicyLbStatusResult(results []*data.StructValue, bindingType bindings.BindingType, objPath string, enforcementPointPath string) (interface{}, error) {
+	converter := bindings.NewTypeConverter()
+	for _, result := range results {
+		resultPoint := getPolicyLbStatusResultEnforcementPoint(result)
+		if resultPoint != "" && enforcementPointPath != "" && resultPoint != enforcementPointPath {
+			log.Printf("[DEBUG] Skipping LB status result for %s from enforcement point %s", objPath, resultPoint)
+			continue
+		}
+		obj, errs := converter.ConvertToGolang(result, bindingType)
+		if len(errs) == 0 {
+			return obj, nil
+		}
+		log.Printf("[DEBUG] Skipping LB status result for %s: %v", objPath, errs[0])
+	}
+
+	return nil, fmt.Errorf("No runtime status found for %s on enforcement point %s", objPath, enforcementPointPath)
+}
+
+func getPolicyLbEnforcementPointPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Policy path of the enforcement point to retrieve status from",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validatePolicyPath(),
+	}
+}
+
+func getPolicyLbEnforcementPointPathFromSchema(d *schema.ResourceData, m interface{}) string {
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	if enforcementPointPath == "" {
+		enforcementPointPath = getPolicyEnforcementPointPath(m)
+	}
+	return enforcementPointPath
+}
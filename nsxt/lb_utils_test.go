@@ -0,0 +1,88 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testPolicyLbPoolStatusValue(t *testing.T, enforcementPointPath string, status string) *data.StructValue {
+	poolPath := "/infra/lb-pools/pool1"
+	obj := model.LBPoolStatus{
+		PoolPath:     &poolPath,
+		Status:       &status,
+		ResourceType: model.LBPoolStatus__TYPE_IDENTIFIER,
+	}
+	if enforcementPointPath != "" {
+		obj.EnforcementPointPath = &enforcementPointPath
+	}
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(obj, model.LBPoolStatusBindingType())
+	if len(errs) > 0 {
+		t.Fatalf("failed to convert status: %v", errs[0])
+	}
+	return dataValue.(*data.StructValue)
+}
+
+func TestGetPolicyLbStatusResult(t *testing.T) {
+	sitePath := "/infra/sites/default/enforcement-points/default"
+	otherPath := "/infra/sites/other/enforcement-points/default"
+
+	tests := []struct {
+		name      string
+		results   []*data.StructValue
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "matching enforcement point",
+			results:  []*data.StructValue{testPolicyLbPoolStatusValue(t, sitePath, "UP")},
+			expected: "UP",
+		},
+		{
+			name: "other enforcement point first",
+			results: []*data.StructValue{
+				testPolicyLbPoolStatusValue(t, otherPath, "DOWN"),
+				testPolicyLbPoolStatusValue(t, sitePath, "UP"),
+			},
+			expected: "UP",
+		},
+		{
+			name:     "enforcement point not reported",
+			results:  []*data.StructValue{testPolicyLbPoolStatusValue(t, "", "UP")},
+			expected: "UP",
+		},
+		{
+			name:      "only other enforcement point",
+			results:   []*data.StructValue{testPolicyLbPoolStatusValue(t, otherPath, "DOWN")},
+			expectErr: true,
+		},
+		{
+			name:      "no results",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			obj, err := getPolicyLbStatusResult(test.results, model.LBPoolStatusBindingType(), "/infra/lb-pools/pool1", sitePath)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			status := obj.(model.LBPoolStatus).Status
+			if status == nil || *status != test.expected {
+				t.Errorf("expected status %s, got %v", test.expected, status)
+			}
+		})
+	}
+}
@@ -310,13 +310,15 @@ func getSecurityPolicyAndGatewayRuleSchema(scopeRequired bool, isIds bool, nsxID
 	return ruleSchema
 }
 
-func getPolicyGatewayPolicySchema() map[string]*schema.Schema {
-	secPolicy := getPolicySecurityPolicySchema(false, true, true)
+func getPolicyGatewayPolicySchema(withRule bool) map[string]*schema.Schema {
+	secPolicy := getPolicySecurityPolicySchema(false, true, withRule)
 	// GW Policies don't support scope
 	delete(secPolicy, "scope")
 	secPolicy["category"].ValidateFunc = validation.StringInSlice(gatewayPolicyCategoryWritableValues, false)
-	// GW Policy rules require scope to be set
-	secPolicy["rule"] = getSecurityPolicyAndGatewayRulesSchema(true, false, true)
+	if withRule {
+		// GW Policy rules require scope to be set
+		secPolicy["rule"] = getSecurityPolicyAndGatewayRulesSchema(true, false, true)
+	}
 	return secPolicy
 }
 
@@ -572,7 +574,8 @@ func parseGatewayPolicyPath(gwPath string) (bool, string) {
 
 func parseLocaleServicePolicyPath(path string) (bool, string, string, error) {
 	segs := strings.Split(path, "/")
-	// Path should be like /infra/tier-0s/aaa/locale-services/default
+	// Path should be like /infra/tier-0s/aaa/locale-services/default, or start with
+	// /global-infra or project infra prefix
 	segCount := len(segs)
 	if (segCount < 6) || (segs[segCount-2] != "locale-services") {
 		// error - this is not a segment path
@@ -580,7 +583,7 @@ func parseLocaleServicePolicyPath(path string) (bool, string, string, error) {
 	}
 
 	localeServiceID := segs[segCount-1]
-	gwPath := strings.Join(segs[:4], "/")
+	gwPath := strings.Join(segs[:segCount-2], "/")
 
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	return isT0, gwID, localeServiceID, nil
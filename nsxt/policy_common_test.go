@@ -0,0 +1,42 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+)
+
+func TestParseLocaleServicePolicyPath(t *testing.T) {
+	tests := []struct {
+		path            string
+		isT0            bool
+		gwID            string
+		localeServiceID string
+		expectErr       bool
+	}{
+		{path: "/infra/tier-0s/gw1/locale-services/default", isT0: true, gwID: "gw1", localeServiceID: "default"},
+		{path: "/infra/tier-1s/gw1/locale-services/ls1", isT0: false, gwID: "gw1", localeServiceID: "ls1"},
+		{path: "/global-infra/tier-0s/gw1/locale-services/ls1", isT0: true, gwID: "gw1", localeServiceID: "ls1"},
+		{path: "/orgs/default/projects/dev/infra/tier-1s/gw1/locale-services/ls1", isT0: false, gwID: "gw1", localeServiceID: "ls1"},
+		{path: "/infra/tier-0s/gw1", expectErr: true},
+		{path: "/infra/tier-0s/gw1/interfaces/if1", expectErr: true},
+	}
+
+	for _, test := range tests {
+		isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(test.path)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("expected error for path %s", test.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for path %s: %v", test.path, err)
+			continue
+		}
+		if isT0 != test.isT0 || gwID != test.gwID || localeServiceID != test.localeServiceID {
+			t.Errorf("path %s: expected (%v, %s, %s), got (%v, %s, %s)", test.path, test.isT0, test.gwID, test.localeServiceID, isT0, gwID, localeServiceID)
+		}
+	}
+}
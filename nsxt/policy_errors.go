@@ -196,6 +196,15 @@ func handleDeleteError(resourceType string, resourceID string, err error) error
 	return logAPIError(msg, err)
 }
 
+func handleDeleteReferrersError(resourceType string, resourceID string, referrers []policyResourceReferrer, err error) error {
+	msg := fmt.Sprintf("Failed to delete %s %s since it is referenced by the following objects:", resourceType, resourceID)
+	for _, referrer := range referrers {
+		msg += fmt.Sprintf("\n  %s (%s)", referrer.Path, referrer.ResourceType)
+	}
+	msg += "\n"
+	return logAPIError(msg, err)
+}
+
 func handleMultitenancyTier0Error() error {
 	return fmt.Errorf("context use not supported with Tier0 gateways")
 }
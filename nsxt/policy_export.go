@@ -0,0 +1,461 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// ExportOptions defines the scope of policy configuration export
+type ExportOptions struct {
+	// Export objects of this project rather than objects under /infra
+	ProjectID string
+	// Only export objects under this domain
+	Domain string
+	// Only export this Tier1 gateway and objects under it
+	Tier1ID string
+}
+
+// Policy object types supported by export
+type policyExportType struct {
+	// NSX resource type, as seen by search API
+	nsxType string
+	// Terraform resource type, or a function to determine it based on NSX object
+	tfType     string
+	tfTypeFunc func(obj *data.StructValue, path string) string
+	// Import ID is the object path, unless importByID is specified
+	importByID bool
+	// Type is not supported within projects or with Global Manager
+	localOnly bool
+	// Type is not supported within projects
+	noMultitenancy bool
+}
+
+var policyExportTypes = []policyExportType{
+	{nsxType: "Tier0", tfType: "nsxt_policy_tier0_gateway", importByID: true, noMultitenancy: true},
+	{nsxType: "Tier1", tfType: "nsxt_policy_tier1_gateway"},
+	{nsxType: "Segment", tfTypeFunc: policyExportSegmentType},
+	{nsxType: "StaticRoutes", tfType: "nsxt_policy_static_route"},
+	{nsxType: "PolicyNatRule", tfType: "nsxt_policy_nat_rule"},
+	{nsxType: "Group", tfType: "nsxt_policy_group"},
+	{nsxType: "Service", tfType: "nsxt_policy_service"},
+	{nsxType: "PolicyContextProfile", tfType: "nsxt_policy_context_profile"},
+	{nsxType: "SecurityPolicy", tfType: "nsxt_policy_security_policy"},
+	{nsxType: "GatewayPolicy", tfType: "nsxt_policy_gateway_policy"},
+	{nsxType: "IpAddressBlock", tfType: "nsxt_policy_ip_block"},
+	{nsxType: "IpAddressPool", tfType: "nsxt_policy_ip_pool"},
+	{nsxType: "DhcpServerConfig", tfType: "nsxt_policy_dhcp_server"},
+	{nsxType: "DhcpRelayConfig", tfType: "nsxt_policy_dhcp_relay"},
+	{nsxType: "PolicyDnsForwarderZone", tfType: "nsxt_policy_dns_forwarder_zone"},
+	{nsxType: "QoSProfile", tfType: "nsxt_policy_qos_profile"},
+	{nsxType: "SpoofGuardProfile", tfType: "nsxt_policy_spoof_guard_profile"},
+	{nsxType: "IPDiscoveryProfile", tfType: "nsxt_policy_ip_discovery_profile"},
+	{nsxType: "MacDiscoveryProfile", tfType: "nsxt_policy_mac_discovery_profile"},
+	{nsxType: "SegmentSecurityProfile", tfType: "nsxt_policy_segment_security_profile"},
+	{nsxType: "LBService", tfType: "nsxt_policy_lb_service", importByID: true, localOnly: true},
+	{nsxType: "LBPool", tfType: "nsxt_policy_lb_pool", importByID: true, localOnly: true},
+	{nsxType: "LBVirtualServer", tfType: "nsxt_policy_lb_virtual_server", importByID: true, localOnly: true},
+	{nsxType: "LBHttpProfile", tfType: "nsxt_policy_lb_http_application_profile", localOnly: true},
+	{nsxType: "LBClientSslProfile", tfType: "nsxt_policy_lb_client_ssl_profile", localOnly: true},
+	{nsxType: "LBHttpMonitorProfile", tfType: "nsxt_policy_lb_http_monitor_profile", localOnly: true},
+	{nsxType: "LBHttpsMonitorProfile", tfType: "nsxt_policy_lb_https_monitor_profile", localOnly: true},
+	{nsxType: "LBIcmpMonitorProfile", tfType: "nsxt_policy_lb_icmp_monitor_profile", localOnly: true},
+	{nsxType: "LBPassiveMonitorProfile", tfType: "nsxt_policy_lb_passive_monitor_profile", localOnly: true},
+	{nsxType: "LBTcpMonitorProfile", tfType: "nsxt_policy_lb_tcp_monitor_profile", localOnly: true},
+	{nsxType: "LBUdpMonitorProfile", tfType: "nsxt_policy_lb_udp_monitor_profile", localOnly: true},
+}
+
+// Attributes that are rendered first in exported resources, for readability
+var policyExportLeadingAttrs = []string{"nsx_id", "display_name", "description"}
+
+var policyExportNameRegexp = regexp.MustCompile("[^a-z0-9_]+")
+
+type policyExportObject struct {
+	tfType   string
+	name     string
+	path     string
+	importID string
+	resource *schema.Resource
+	data     *schema.ResourceData
+}
+
+func policyExportSegmentType(obj *data.StructValue, path string) string {
+	if strings.Contains(path, "/tier-1s/") {
+		return "nsxt_policy_fixed_segment"
+	}
+	if value, err := obj.Field("vlan_ids"); err == nil {
+		if vlans, ok := value.(*data.ListValue); ok && !vlans.IsEmpty() {
+			return "nsxt_policy_vlan_segment"
+		}
+	}
+	return "nsxt_policy_segment"
+}
+
+func getPolicyExportScope(options ExportOptions, isGlobalManager bool) (utl.SessionContext, string, error) {
+	sessionContext := utl.SessionContext{ClientType: utl.Local}
+	basePath := "/infra"
+	if options.ProjectID != "" {
+		if isGlobalManager {
+			return sessionContext, "", fmt.Errorf("project export is not supported with NSX Global Manager")
+		}
+		sessionContext = utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: options.ProjectID}
+		basePath = fmt.Sprintf("/orgs/%s/projects/%s/infra", utl.DefaultOrgID, options.ProjectID)
+	} else if isGlobalManager {
+		sessionContext.ClientType = utl.Global
+		basePath = "/global-infra"
+	}
+
+	if options.Domain != "" && options.Tier1ID != "" {
+		return sessionContext, "", fmt.Errorf("domain and Tier1 export scopes can not be combined")
+	}
+	if options.Domain != "" {
+		return sessionContext, fmt.Sprintf("%s/domains/%s", basePath, options.Domain), nil
+	}
+	if options.Tier1ID != "" {
+		return sessionContext, fmt.Sprintf("%s/tier-1s/%s", basePath, options.Tier1ID), nil
+	}
+	return sessionContext, basePath, nil
+}
+
+func listPolicyExportCandidates(connector client.Connector, sessionContext utl.SessionContext, nsxType string) ([]*data.StructValue, error) {
+	query := fmt.Sprintf("resource_type:%s AND marked_for_delete:false", nsxType)
+	switch sessionContext.ClientType {
+	case utl.Local:
+		return searchLMPolicyResources(connector, query)
+	case utl.Global:
+		return searchGMPolicyResources(connector, query)
+	case utl.Multitenancy:
+		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, sessionContext.ProjectID, query)
+	}
+	return nil, fmt.Errorf("invalid ClientType %d", sessionContext.ClientType)
+}
+
+func getPolicyExportResourceName(displayName string, id string, usedNames map[string]bool) string {
+	name := strings.Trim(policyExportNameRegexp.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		name = strings.Trim(policyExportNameRegexp.ReplaceAllString(strings.ToLower(id), "_"), "_")
+	}
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "obj_" + name
+	}
+
+	candidate := name
+	for i := 2; usedNames[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	usedNames[candidate] = true
+	return candidate
+}
+
+// Import object into resource data using the resource importer, and populate it with resource Read
+func policyExportReadObject(r *schema.Resource, importID string, m interface{}) ([]*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(importID)
+
+	imported := []*schema.ResourceData{d}
+	if r.Importer != nil && r.Importer.State != nil {
+		var err error
+		imported, err = r.Importer.State(d, m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var result []*schema.ResourceData
+	for _, importedData := range imported {
+		state, diags := r.RefreshWithoutUpgrade(context.Background(), importedData.State(), m)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to read %s: %v", importID, diags[0].Summary)
+		}
+		if state == nil || state.ID == "" {
+			// Object is gone
+			continue
+		}
+		result = append(result, r.Data(state))
+	}
+	return result, nil
+}
+
+// ExportPolicyConfiguration connects to NSX with provider settings taken from NSXT_*
+// environment variables, and writes terraform configuration with import blocks for
+// supported policy objects within given scope
+func ExportPolicyConfiguration(w io.Writer, options ExportOptions) error {
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags.HasError() {
+		return fmt.Errorf("failed to configure NSX provider: %s", diags[0].Summary)
+	}
+	m := provider.Meta()
+	connector := getPolicyConnector(m)
+	isGlobalManager := isPolicyGlobalManager(m)
+
+	sessionContext, scopePath, err := getPolicyExportScope(options, isGlobalManager)
+	if err != nil {
+		return err
+	}
+
+	converter := bindings.NewTypeConverter()
+	usedNames := make(map[string]bool)
+	var objects []policyExportObject
+	for _, exportType := range policyExportTypes {
+		if sessionContext.ClientType != utl.Local && exportType.localOnly {
+			continue
+		}
+		if sessionContext.ClientType == utl.Multitenancy && exportType.noMultitenancy {
+			continue
+		}
+
+		results, err := listPolicyExportCandidates(connector, sessionContext, exportType.nsxType)
+		if err != nil {
+			return logAPIError(fmt.Sprintf("Failed to list %s objects", exportType.nsxType), err)
+		}
+
+		for _, result := range results {
+			dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			obj := dataValue.(model.PolicyResource)
+			if obj.Path == nil || obj.Id == nil {
+				continue
+			}
+			path := *obj.Path
+			if path != scopePath && !strings.HasPrefix(path, scopePath+"/") {
+				continue
+			}
+			if (obj.SystemOwned != nil && *obj.SystemOwned) || (obj.CreateUser != nil && *obj.CreateUser == "system") {
+				// Default objects are not managed by the user
+				continue
+			}
+
+			tfType := exportType.tfType
+			if exportType.tfTypeFunc != nil {
+				tfType = exportType.tfTypeFunc(result, path)
+			}
+			r := provider.ResourcesMap[tfType]
+			importID := path
+			if exportType.importByID {
+				importID = *obj.Id
+			}
+
+			log.Printf("[INFO] Exporting %s %s", tfType, path)
+			readData, err := policyExportReadObject(r, importID, m)
+			if err != nil {
+				return err
+			}
+			for _, d := range readData {
+				displayName := ""
+				if obj.DisplayName != nil {
+					displayName = *obj.DisplayName
+				}
+				objects = append(objects, policyExportObject{
+					tfType:   tfType,
+					name:     getPolicyExportResourceName(displayName, *obj.Id, usedNames),
+					path:     path,
+					importID: importID,
+					resource: r,
+					data:     d,
+				})
+			}
+		}
+	}
+
+	// References between exported objects are rendered as resource expressions
+	references := make(map[string]string)
+	for _, obj := range objects {
+		references[obj.path] = fmt.Sprintf("%s.%s.path", obj.tfType, obj.name)
+	}
+
+	for _, obj := range objects {
+		exportCtx := policyExportContext{
+			references: references,
+			selfPath:   obj.path,
+			varPrefix:  fmt.Sprintf("%s_%s", strings.TrimPrefix(obj.tfType, "nsxt_"), obj.name),
+		}
+		var resourceBlock strings.Builder
+		fmt.Fprintf(&resourceBlock, "resource %q %q {\n", obj.tfType, obj.name)
+		writePolicyExportAttributes(&resourceBlock, 1, obj.resource.Schema, func(key string) interface{} { return obj.data.Get(key) }, &exportCtx)
+		resourceBlock.WriteString("}\n\n")
+
+		var b strings.Builder
+		writePolicyExportVariables(&b, exportCtx.variables)
+		b.WriteString(resourceBlock.String())
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", obj.tfType, obj.name, quotePolicyExportString(obj.importID))
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Exported %d objects under %s", len(objects), scopePath)
+	return nil
+}
+
+func getPolicyExportAttrKeys(attrSchema map[string]*schema.Schema) []string {
+	var keys []string
+	for key := range attrSchema {
+		if !stringInList(key, policyExportLeadingAttrs) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var leading []string
+	for _, key := range policyExportLeadingAttrs {
+		if _, ok := attrSchema[key]; ok {
+			leading = append(leading, key)
+		}
+	}
+	return append(leading, keys...)
+}
+
+func isPolicyExportValueSkipped(attrSchema *schema.Schema, value interface{}) bool {
+	if attrSchema.Computed && !attrSchema.Optional {
+		return true
+	}
+	if attrSchema.Deprecated != "" || attrSchema.Sensitive {
+		return true
+	}
+	if attrSchema.Required {
+		return false
+	}
+	if attrSchema.Default != nil {
+		return reflect.DeepEqual(value, attrSchema.Default)
+	}
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return reflect.ValueOf(value).IsZero()
+}
+
+func quotePolicyExportString(value string) string {
+	replacer := strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\r", "\\r", "\t", "\\t", "${", "$${", "%{", "%%{")
+	return "\"" + replacer.Replace(value) + "\""
+}
+
+func formatPolicyExportValue(value interface{}, references map[string]string, selfPath string) string {
+	switch v := value.(type) {
+	case string:
+		if expression, ok := references[v]; ok && v != selfPath {
+			return expression
+		}
+		return quotePolicyExportString(v)
+	case []interface{}:
+		var elems []string
+		for _, elem := range v {
+			elems = append(elems, formatPolicyExportValue(elem, references, selfPath))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var elems []string
+		for _, key := range keys {
+			elems = append(elems, fmt.Sprintf("%s = %s", quotePolicyExportString(key), formatPolicyExportValue(v[key], references, selfPath)))
+		}
+		return "{ " + strings.Join(elems, ", ") + " }"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// Context for rendering attributes of a single exported resource
+type policyExportContext struct {
+	// Expressions for policy paths of exported objects
+	references map[string]string
+	// Path of the object being rendered, which should not reference itself
+	selfPath string
+	// Prefix for names of variables generated for sensitive attributes
+	varPrefix string
+	// Variables referenced by rendered attributes, with their types
+	variables []policyExportVariable
+}
+
+type policyExportVariable struct {
+	name    string
+	varType string
+}
+
+func getPolicyExportVariableType(attrSchema *schema.Schema) string {
+	switch attrSchema.Type {
+	case schema.TypeString:
+		return "string"
+	case schema.TypeInt, schema.TypeFloat:
+		return "number"
+	case schema.TypeBool:
+		return "bool"
+	}
+	return "any"
+}
+
+func writePolicyExportVariables(b *strings.Builder, variables []policyExportVariable) {
+	for _, variable := range variables {
+		fmt.Fprintf(b, "variable %q {\n  type      = %s\n  sensitive = true\n}\n\n", variable.name, variable.varType)
+	}
+}
+
+func writePolicyExportAttributes(b *strings.Builder, indent int, attrSchema map[string]*schema.Schema, getValue func(string) interface{}, exportCtx *policyExportContext) {
+	prefix := strings.Repeat("  ", indent)
+	for _, key := range getPolicyExportAttrKeys(attrSchema) {
+		if attrSchema[key].Sensitive && attrSchema[key].Required {
+			// Sensitive values are not exposed by NSX, hence the user needs to provide them
+			variable := policyExportVariable{
+				name:    fmt.Sprintf("%s_%s", exportCtx.varPrefix, key),
+				varType: getPolicyExportVariableType(attrSchema[key]),
+			}
+			exportCtx.variables = append(exportCtx.variables, variable)
+			fmt.Fprintf(b, "%s%s = var.%s\n", prefix, key, variable.name)
+			continue
+		}
+
+		value := getValue(key)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		if isPolicyExportValueSkipped(attrSchema[key], value) {
+			continue
+		}
+
+		nested, isBlock := attrSchema[key].Elem.(*schema.Resource)
+		if !isBlock {
+			fmt.Fprintf(b, "%s%s = %s\n", prefix, key, formatPolicyExportValue(value, exportCtx.references, exportCtx.selfPath))
+			continue
+		}
+
+		elems, _ := value.([]interface{})
+		varPrefix := exportCtx.varPrefix
+		for i, elem := range elems {
+			elemMap, ok := elem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			exportCtx.varPrefix = fmt.Sprintf("%s_%s_%d", varPrefix, key, i)
+			fmt.Fprintf(b, "%s%s {\n", prefix, key)
+			writePolicyExportAttributes(b, indent+1, nested.Schema, func(nestedKey string) interface{} { return elemMap[nestedKey] }, exportCtx)
+			fmt.Fprintf(b, "%s}\n", prefix)
+		}
+		exportCtx.varPrefix = varPrefix
+	}
+}
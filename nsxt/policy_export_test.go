@@ -0,0 +1,180 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetPolicyExportResourceName(t *testing.T) {
+	usedNames := make(map[string]bool)
+	tests := []struct {
+		displayName string
+		id          string
+		expected    string
+	}{
+		{displayName: "Web Servers", id: "web", expected: "web_servers"},
+		{displayName: "Web Servers", id: "web2", expected: "web_servers_2"},
+		{displayName: "--", id: "my-id", expected: "my_id"},
+		{displayName: "1st tier", id: "t1", expected: "obj_1st_tier"},
+		{displayName: "", id: "", expected: "obj_"},
+	}
+
+	for _, test := range tests {
+		name := getPolicyExportResourceName(test.displayName, test.id, usedNames)
+		if name != test.expected {
+			t.Errorf("name for %q/%q: expected %s, got %s", test.displayName, test.id, test.expected, name)
+		}
+	}
+}
+
+func TestQuotePolicyExportString(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "plain", expected: `"plain"`},
+		{value: `with "quotes"`, expected: `"with \"quotes\""`},
+		{value: "line\nbreak", expected: `"line\nbreak"`},
+		{value: `back\slash`, expected: `"back\\slash"`},
+		{value: "${interpolation}", expected: `"$${interpolation}"`},
+		{value: "%{directive}", expected: `"%%{directive}"`},
+	}
+
+	for _, test := range tests {
+		quoted := quotePolicyExportString(test.value)
+		if quoted != test.expected {
+			t.Errorf("quoting %q: expected %s, got %s", test.value, test.expected, quoted)
+		}
+	}
+}
+
+func TestFormatPolicyExportValue(t *testing.T) {
+	references := map[string]string{
+		"/infra/domains/default/groups/g1": "nsxt_policy_group.g1.path",
+		"/infra/domains/default/groups/g2": "nsxt_policy_group.g2.path",
+	}
+	selfPath := "/infra/domains/default/groups/g2"
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "string", value: "abc", expected: `"abc"`},
+		{name: "int", value: 5, expected: "5"},
+		{name: "bool", value: true, expected: "true"},
+		{name: "reference", value: "/infra/domains/default/groups/g1", expected: "nsxt_policy_group.g1.path"},
+		{name: "self reference", value: selfPath, expected: `"` + selfPath + `"`},
+		{name: "list", value: []interface{}{"a", "/infra/domains/default/groups/g1"}, expected: `["a", nsxt_policy_group.g1.path]`},
+		{name: "map", value: map[string]interface{}{"b": "2", "a": "1"}, expected: `{ "a" = "1", "b" = "2" }`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatted := formatPolicyExportValue(test.value, references, selfPath)
+			if formatted != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, formatted)
+			}
+		})
+	}
+}
+
+func TestIsPolicyExportValueSkipped(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   *schema.Schema
+		value    interface{}
+		expected bool
+	}{
+		{name: "computed only", schema: &schema.Schema{Type: schema.TypeString, Computed: true}, value: "a", expected: true},
+		{name: "optional computed", schema: &schema.Schema{Type: schema.TypeString, Optional: true, Computed: true}, value: "a", expected: false},
+		{name: "deprecated", schema: &schema.Schema{Type: schema.TypeString, Optional: true, Deprecated: "old"}, value: "a", expected: true},
+		{name: "sensitive", schema: &schema.Schema{Type: schema.TypeString, Optional: true, Sensitive: true}, value: "a", expected: true},
+		{name: "required empty", schema: &schema.Schema{Type: schema.TypeString, Required: true}, value: "", expected: false},
+		{name: "matches default", schema: &schema.Schema{Type: schema.TypeInt, Optional: true, Default: 3}, value: 3, expected: true},
+		{name: "differs from default", schema: &schema.Schema{Type: schema.TypeInt, Optional: true, Default: 3}, value: 4, expected: false},
+		{name: "empty string", schema: &schema.Schema{Type: schema.TypeString, Optional: true}, value: "", expected: true},
+		{name: "empty list", schema: &schema.Schema{Type: schema.TypeList, Optional: true}, value: []interface{}{}, expected: true},
+		{name: "list", schema: &schema.Schema{Type: schema.TypeList, Optional: true}, value: []interface{}{"a"}, expected: false},
+		{name: "nil", schema: &schema.Schema{Type: schema.TypeList, Optional: true}, value: nil, expected: true},
+		{name: "false", schema: &schema.Schema{Type: schema.TypeBool, Optional: true}, value: false, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			skipped := isPolicyExportValueSkipped(test.schema, test.value)
+			if skipped != test.expected {
+				t.Errorf("expected skipped %v, got %v", test.expected, skipped)
+			}
+		})
+	}
+}
+
+func TestWritePolicyExportAttributes(t *testing.T) {
+	attrSchema := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString, Required: true},
+		"description":  {Type: schema.TypeString, Optional: true},
+		"path":         {Type: schema.TypeString, Computed: true},
+		"group_path":   {Type: schema.TypeString, Optional: true},
+		"password":     {Type: schema.TypeString, Required: true, Sensitive: true},
+		"token":        {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"enabled":      {Type: schema.TypeBool, Optional: true, Default: true},
+		"credential": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {Type: schema.TypeString, Required: true},
+					"secret":   {Type: schema.TypeString, Required: true, Sensitive: true},
+				},
+			},
+		},
+	}
+	values := map[string]interface{}{
+		"display_name": "test",
+		"description":  "",
+		"path":         "/infra/foo/test",
+		"group_path":   "/infra/domains/default/groups/g1",
+		"password":     "",
+		"token":        "",
+		"enabled":      false,
+		"credential": []interface{}{
+			map[string]interface{}{"username": "admin", "secret": ""},
+		},
+	}
+
+	exportCtx := policyExportContext{
+		references: map[string]string{"/infra/domains/default/groups/g1": "nsxt_policy_group.g1.path"},
+		selfPath:   "/infra/foo/test",
+		varPrefix:  "policy_foo_test",
+	}
+	var b strings.Builder
+	writePolicyExportAttributes(&b, 1, attrSchema, func(key string) interface{} { return values[key] }, &exportCtx)
+
+	expected := `  display_name = "test"
+  credential {
+    secret = var.policy_foo_test_credential_0_secret
+    username = "admin"
+  }
+  enabled = false
+  group_path = nsxt_policy_group.g1.path
+  password = var.policy_foo_test_password
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+
+	if len(exportCtx.variables) != 2 {
+		t.Fatalf("expected 2 variables, got %d", len(exportCtx.variables))
+	}
+	var vars strings.Builder
+	writePolicyExportVariables(&vars, exportCtx.variables)
+	if !strings.Contains(vars.String(), "variable \"policy_foo_test_password\" {\n  type      = string\n  sensitive = true\n}") {
+		t.Errorf("unexpected variables:\n%s", vars.String())
+	}
+}
@@ -0,0 +1,287 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	vapiErrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Generic policy API invocation for objects that are not covered by SDK clients.
+// Requests are sent via the provider connector, hence authentication, retries and
+// custom headers are identical to the rest of policy resources.
+
+const policyGenericBodyField = "body"
+
+var policyGenericErrorCodes = map[string]int{
+	"com.vmware.vapi.std.errors.invalid_request":       400,
+	"com.vmware.vapi.std.errors.unauthorized":          403,
+	"com.vmware.vapi.std.errors.not_found":             404,
+	"com.vmware.vapi.std.errors.concurrent_change":     412,
+	"com.vmware.vapi.std.errors.internal_server_error": 500,
+	"com.vmware.vapi.std.errors.service_unavailable":   503,
+}
+
+// Object metadata that is assigned by NSX and should not be part of user intent
+var policyGenericSystemFields = []string{
+	"path",
+	"parent_path",
+	"relative_path",
+	"remote_path",
+	"realization_id",
+	"unique_id",
+	"marked_for_delete",
+	"overridden",
+	"owner_id",
+	"origin_site_id",
+}
+
+// Returns full API URL for policy path, taking project context into account
+func getPolicyGenericURL(context utl.SessionContext, policyPath string) string {
+	if strings.HasPrefix(policyPath, "/global-infra") {
+		return "/global-manager/api/v1" + policyPath
+	}
+	if context.ClientType == utl.Multitenancy && strings.HasPrefix(policyPath, "/infra/") {
+		policyPath = fmt.Sprintf("/orgs/%s/projects/%s%s", utl.DefaultOrgID, context.ProjectID, policyPath)
+	}
+	return "/policy/api/v1" + policyPath
+}
+
+func getPolicyGenericRestMetadata(method string, url string, withBody bool) protocol.OperationRestMetadata {
+	fields := map[string]bindings.BindingType{}
+	fieldNameMap := map[string]string{}
+	paramsTypeMap := map[string]bindings.BindingType{}
+	bodyParamName := ""
+	if withBody {
+		fields[policyGenericBodyField] = bindings.NewDynamicStructType(nil)
+		fieldNameMap[policyGenericBodyField] = "Body"
+		paramsTypeMap[policyGenericBodyField] = bindings.NewDynamicStructType(nil)
+		bodyParamName = policyGenericBodyField
+	}
+
+	successCode := 200
+	if method == http.MethodDelete {
+		successCode = 204
+	}
+
+	return protocol.NewOperationRestMetadata(
+		fields,
+		fieldNameMap,
+		paramsTypeMap,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		"",
+		bodyParamName,
+		method,
+		url,
+		"application/json",
+		map[string]string{},
+		successCode,
+		"",
+		map[string]map[string]string{},
+		policyGenericErrorCodes)
+}
+
+func policyGenericInvoke(connector client.Connector, context utl.SessionContext, method string, policyPath string, body *data.StructValue) (data.DataValue, error) {
+	url := getPolicyGenericURL(context, policyPath)
+	executionContext := connector.NewExecutionContext()
+	executionContext.SetConnectionMetadata(core.RESTMetadataKey, getPolicyGenericRestMetadata(method, url, body != nil))
+	executionContext.SetConnectionMetadata(core.ResponseTypeKey, core.NewResponseType(true, false))
+
+	input := data.NewStructValue("operation-input", map[string]data.DataValue{})
+	if body != nil {
+		input.SetField(policyGenericBodyField, body)
+	}
+
+	methodResult := connector.GetApiProvider().Invoke("com.vmware.nsx_policy.generic", strings.ToLower(method), input, executionContext)
+	if methodResult.IsSuccess() {
+		return methodResult.Output(), nil
+	}
+
+	errorBinding := vapiErrors.ERROR_BINDINGS_MAP[methodResult.Error().Name()]
+	if errorBinding == nil {
+		return nil, fmt.Errorf("%s %s failed with %s", method, url, methodResult.Error().Name())
+	}
+	methodError, errs := connector.TypeConverter().ConvertToGolang(methodResult.Error(), errorBinding)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return nil, methodError.(error)
+}
+
+// Retrieves policy object as a generic JSON map
+func policyGenericGet(connector client.Connector, context utl.SessionContext, policyPath string) (map[string]interface{}, error) {
+	output, err := policyGenericInvoke(connector, context, http.MethodGet, policyPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonStr, err := cleanjson.NewDataValueToJsonEncoder().Encode(output)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := decodePolicyGenericJSON([]byte(jsonStr), &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Decodes JSON keeping numbers in json.Number form, so that large int64
+// values (such as revisions or IDs) do not lose precision as float64
+func decodePolicyGenericJSON(jsonBytes []byte, obj interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	return decoder.Decode(obj)
+}
+
+func policyGenericPatch(connector client.Connector, context utl.SessionContext, policyPath string, obj map[string]interface{}) error {
+	// Decoder expects numbers in json.Number form
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var jsonObj interface{}
+	if err := decodePolicyGenericJSON(jsonBytes, &jsonObj); err != nil {
+		return err
+	}
+	dataValue, err := cleanjson.NewJsonToDataValueDecoder().Decode(jsonObj)
+	if err != nil {
+		return err
+	}
+	body, ok := dataValue.(*data.StructValue)
+	if !ok {
+		return fmt.Errorf("JSON object expected for %s", policyPath)
+	}
+	_, err = policyGenericInvoke(connector, context, http.MethodPatch, policyPath, body)
+	return err
+}
+
+func policyGenericDelete(connector client.Connector, context utl.SessionContext, policyPath string) error {
+	_, err := policyGenericInvoke(connector, context, http.MethodDelete, policyPath, nil)
+	return err
+}
+
+// Retrieves policy object and converts it to SDK model of given binding type.
+// This is useful for objects that have SDK model, but lack SDK client for some
+// of the contexts (such as multitenancy)
+func policyGenericGetModel(connector client.Connector, context utl.SessionContext, policyPath string, bindingType bindings.BindingType) (interface{}, error) {
+	output, err := policyGenericInvoke(connector, context, http.MethodGet, policyPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, errs := connector.TypeConverter().ConvertToGolang(output, bindingType)
+	if len(errs) > 0 {
+		return nil, bindings.VAPIerrorsToError(errs)
+	}
+	return obj, nil
+}
+
+func policyGenericPatchModel(connector client.Connector, context utl.SessionContext, policyPath string, obj interface{}, bindingType bindings.BindingType) error {
+	dataValue, errs := connector.TypeConverter().ConvertToVapi(obj, bindingType)
+	if len(errs) > 0 {
+		return bindings.VAPIerrorsToError(errs)
+	}
+	body, ok := dataValue.(*data.StructValue)
+	if !ok {
+		return fmt.Errorf("Structure expected for %s", policyPath)
+	}
+	_, err := policyGenericInvoke(connector, context, http.MethodPatch, policyPath, body)
+	return err
+}
+
+// Removes NSX-assigned metadata (such as _revision or path) from object
+func stripPolicyGenericSystemFields(obj map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range obj {
+		if strings.HasPrefix(key, "_") || stringInList(key, policyGenericSystemFields) {
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// Returns the portion of observed object that corresponds to keys present in
+// intended object, so that only attributes managed by the user are compared
+func projectPolicyGenericObject(intended interface{}, observed interface{}) interface{} {
+	switch intendedValue := intended.(type) {
+	case map[string]interface{}:
+		observedMap, ok := observed.(map[string]interface{})
+		if !ok {
+			return observed
+		}
+		result := make(map[string]interface{})
+		for key, value := range intendedValue {
+			if observedValue, found := observedMap[key]; found {
+				result[key] = projectPolicyGenericObject(value, observedValue)
+			}
+		}
+		return result
+	case []interface{}:
+		observedList, ok := observed.([]interface{})
+		if !ok || len(observedList) != len(intendedValue) {
+			return observed
+		}
+		result := make([]interface{}, len(observedList))
+		for i := range observedList {
+			result[i] = projectPolicyGenericObject(intendedValue[i], observedList[i])
+		}
+		return result
+	}
+	return observed
+}
+
+// Overrides fields specified in dot notation (such as "a.b") in observed object
+// with intended values, so that changes in those fields are not reported as drift
+func applyPolicyGenericIgnoreFields(intended map[string]interface{}, observed map[string]interface{}, ignoreFields []string) {
+	for _, field := range ignoreFields {
+		keys := strings.Split(field, ".")
+		intendedMap := intended
+		observedMap := observed
+		for i, key := range keys {
+			if i == len(keys)-1 {
+				if value, ok := intendedMap[key]; ok {
+					observedMap[key] = value
+				} else {
+					delete(observedMap, key)
+				}
+				break
+			}
+			nextIntended, ok1 := intendedMap[key].(map[string]interface{})
+			nextObserved, ok2 := observedMap[key].(map[string]interface{})
+			if !ok1 || !ok2 {
+				break
+			}
+			intendedMap = nextIntended
+			observedMap = nextObserved
+		}
+	}
+}
+
+func isPolicyGenericJSONEqual(left string, right string) bool {
+	var leftObj, rightObj interface{}
+	if decodePolicyGenericJSON([]byte(left), &leftObj) != nil || decodePolicyGenericJSON([]byte(right), &rightObj) != nil {
+		return false
+	}
+	return reflect.DeepEqual(leftObj, rightObj)
+}
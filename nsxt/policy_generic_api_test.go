@@ -0,0 +1,239 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func testPolicyGenericDecode(t *testing.T, jsonStr string) map[string]interface{} {
+	var obj map[string]interface{}
+	if err := decodePolicyGenericJSON([]byte(jsonStr), &obj); err != nil {
+		t.Fatalf("failed to decode %s: %v", jsonStr, err)
+	}
+	return obj
+}
+
+func TestDecodePolicyGenericJSON(t *testing.T) {
+	obj := testPolicyGenericDecode(t, `{"_revision": 9007199254740993, "ratio": 0.5}`)
+
+	revision, ok := obj["_revision"].(json.Number)
+	if !ok {
+		t.Fatalf("expected json.Number, got %T", obj["_revision"])
+	}
+	value, err := revision.Int64()
+	if err != nil || value != 9007199254740993 {
+		t.Errorf("expected 9007199254740993, got %d (%v)", value, err)
+	}
+
+	encoded, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"_revision":9007199254740993,"ratio":0.5}` {
+		t.Errorf("unexpected encoding %s", encoded)
+	}
+}
+
+func TestIsPolicyGenericJSONEqual(t *testing.T) {
+	tests := []struct {
+		left     string
+		right    string
+		expected bool
+	}{
+		{left: `{"a": 1, "b": "x"}`, right: `{"b":"x","a":1}`, expected: true},
+		{left: `{"a": 9007199254740993}`, right: `{"a": 9007199254740992}`, expected: false},
+		{left: `{"a": [1, 2]}`, right: `{"a": [2, 1]}`, expected: false},
+		{left: `{"a": 1}`, right: `not json`, expected: false},
+	}
+
+	for _, test := range tests {
+		if isPolicyGenericJSONEqual(test.left, test.right) != test.expected {
+			t.Errorf("comparing %s and %s: expected %v", test.left, test.right, test.expected)
+		}
+	}
+}
+
+func TestProjectPolicyGenericObject(t *testing.T) {
+	observed := `{
+  "display_name": "test",
+  "description": "observed",
+  "_revision": 3,
+  "expression": [{"resource_type": "IPAddressExpression", "ip_addresses": ["1.1.1.1"], "id": "e1"}],
+  "extended": {"a": 1, "b": 2}
+}`
+
+	tests := []struct {
+		name     string
+		intended string
+		expected string
+	}{
+		{
+			name:     "top level keys",
+			intended: `{"display_name": "test"}`,
+			expected: `{"display_name": "test"}`,
+		},
+		{
+			name:     "key missing in observed",
+			intended: `{"display_name": "test", "tags": []}`,
+			expected: `{"display_name": "test"}`,
+		},
+		{
+			name:     "nested map",
+			intended: `{"extended": {"a": 5}}`,
+			expected: `{"extended": {"a": 1}}`,
+		},
+		{
+			name:     "list of same length",
+			intended: `{"expression": [{"ip_addresses": ["2.2.2.2"]}]}`,
+			expected: `{"expression": [{"ip_addresses": ["1.1.1.1"]}]}`,
+		},
+		{
+			name:     "list of different length",
+			intended: `{"expression": []}`,
+			expected: `{"expression": [{"resource_type": "IPAddressExpression", "ip_addresses": ["1.1.1.1"], "id": "e1"}]}`,
+		},
+		{
+			name:     "type mismatch",
+			intended: `{"extended": "abc"}`,
+			expected: `{"extended": {"a": 1, "b": 2}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := projectPolicyGenericObject(testPolicyGenericDecode(t, test.intended), testPolicyGenericDecode(t, observed))
+			expected := testPolicyGenericDecode(t, test.expected)
+			if !reflect.DeepEqual(result, expected) {
+				t.Errorf("expected %v, got %v", expected, result)
+			}
+		})
+	}
+}
+
+func TestApplyPolicyGenericIgnoreFields(t *testing.T) {
+	tests := []struct {
+		name         string
+		intended     string
+		observed     string
+		ignoreFields []string
+		expected     string
+	}{
+		{
+			name:         "top level field",
+			intended:     `{"description": "intended", "display_name": "a"}`,
+			observed:     `{"description": "observed", "display_name": "b"}`,
+			ignoreFields: []string{"description"},
+			expected:     `{"description": "intended", "display_name": "b"}`,
+		},
+		{
+			name:         "field not in intent",
+			intended:     `{"display_name": "a"}`,
+			observed:     `{"description": "observed", "display_name": "a"}`,
+			ignoreFields: []string{"description"},
+			expected:     `{"display_name": "a"}`,
+		},
+		{
+			name:         "nested field",
+			intended:     `{"extended": {"a": 1, "b": 2}}`,
+			observed:     `{"extended": {"a": 3, "b": 4}}`,
+			ignoreFields: []string{"extended.a"},
+			expected:     `{"extended": {"a": 1, "b": 4}}`,
+		},
+		{
+			name:         "nested field in non map",
+			intended:     `{"extended": {"a": 1}}`,
+			observed:     `{"extended": "value"}`,
+			ignoreFields: []string{"extended.a"},
+			expected:     `{"extended": "value"}`,
+		},
+		{
+			name:         "no ignore fields",
+			intended:     `{"description": "intended"}`,
+			observed:     `{"description": "observed"}`,
+			ignoreFields: nil,
+			expected:     `{"description": "observed"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			observed := testPolicyGenericDecode(t, test.observed)
+			applyPolicyGenericIgnoreFields(testPolicyGenericDecode(t, test.intended), observed, test.ignoreFields)
+			expected := testPolicyGenericDecode(t, test.expected)
+			if !reflect.DeepEqual(observed, expected) {
+				t.Errorf("expected %v, got %v", expected, observed)
+			}
+		})
+	}
+}
+
+func TestStripPolicyGenericSystemFields(t *testing.T) {
+	obj := testPolicyGenericDecode(t, `{"_revision": 1, "_create_user": "admin", "path": "/infra/x", "unique_id": "u", "display_name": "x"}`)
+	result := stripPolicyGenericSystemFields(obj)
+	expected := testPolicyGenericDecode(t, `{"display_name": "x"}`)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestGetPolicyGenericURL(t *testing.T) {
+	tests := []struct {
+		context  utl.SessionContext
+		path     string
+		expected string
+	}{
+		{context: utl.SessionContext{ClientType: utl.Local}, path: "/infra/domains/default/groups/g1", expected: "/policy/api/v1/infra/domains/default/groups/g1"},
+		{context: utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: "p1"}, path: "/infra/domains/default/groups/g1", expected: "/policy/api/v1/orgs/default/projects/p1/infra/domains/default/groups/g1"},
+		{context: utl.SessionContext{ClientType: utl.Global}, path: "/global-infra/domains/default/groups/g1", expected: "/global-manager/api/v1/global-infra/domains/default/groups/g1"},
+	}
+
+	for _, test := range tests {
+		url := getPolicyGenericURL(test.context, test.path)
+		if url != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, url)
+		}
+	}
+}
+
+func TestResourceNsxtPolicyGenericImport(t *testing.T) {
+	tests := []struct {
+		importID      string
+		expectErr     bool
+		expectPath    string
+		expectProject string
+	}{
+		{importID: "/infra/domains/default/groups/g1", expectPath: "/infra/domains/default/groups/g1"},
+		{importID: "/orgs/default/projects/p1/infra/domains/default/groups/g1", expectPath: "/infra/domains/default/groups/g1", expectProject: "p1"},
+		{importID: "/orgs/default/projects/p1/vpcs/v1", expectPath: "/orgs/default/projects/p1/vpcs/v1"},
+		{importID: "g1", expectErr: true},
+	}
+
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourceNsxtPolicyGeneric().Schema, map[string]interface{}{})
+		d.SetId(test.importID)
+		_, err := resourceNsxtPolicyGenericImport(d, nil)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("expected error for %s", test.importID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", test.importID, err)
+		}
+		if d.Id() != test.expectPath || d.Get("path").(string) != test.expectPath {
+			t.Errorf("expected path %s, got ID %s and path %s", test.expectPath, d.Id(), d.Get("path"))
+		}
+		if projectID := d.Get("context.0.project_id").(string); projectID != test.expectProject {
+			t.Errorf("expected project %s, got %s", test.expectProject, projectID)
+		}
+	}
+}
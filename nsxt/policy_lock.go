@@ -0,0 +1,90 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"log"
+	"sort"
+	"sync"
+)
+
+// keyedMutex provides a separate lock per key, so that operations on unrelated
+// objects can proceed in parallel. Lock entries are released once no longer in use.
+type keyedMutex struct {
+	mutex sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mutex    sync.Mutex
+	refCount int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{
+		locks: make(map[string]*keyedMutexEntry),
+	}
+}
+
+func (k *keyedMutex) Lock(key string) {
+	k.mutex.Lock()
+	entry, ok := k.locks[key]
+	if !ok {
+		entry = &keyedMutexEntry{}
+		k.locks[key] = entry
+	}
+	entry.refCount++
+	k.mutex.Unlock()
+
+	entry.mutex.Lock()
+}
+
+func (k *keyedMutex) Unlock(key string) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	entry, ok := k.locks[key]
+	if !ok {
+		log.Printf("[ERROR] Unlocking %s which is not locked", key)
+		return
+	}
+	entry.refCount--
+	if entry.refCount == 0 {
+		delete(k.locks, key)
+	}
+	entry.mutex.Unlock()
+}
+
+// Provider-wide locks keyed by policy path of a shared parent object. Resources that
+// read-modify-write a shared parent, or create children that NSX serializes on
+// the parent, should hold the parent lock for the duration of the operation.
+var policyParentLocks = newKeyedMutex()
+
+func lockPolicyParent(parentPath string) {
+	log.Printf("[DEBUG] Locking %s", parentPath)
+	policyParentLocks.Lock(parentPath)
+	log.Printf("[DEBUG] Locked %s", parentPath)
+}
+
+func unlockPolicyParent(parentPath string) {
+	policyParentLocks.Unlock(parentPath)
+	log.Printf("[DEBUG] Unlocked %s", parentPath)
+}
+
+// Locks multiple parents in sorted order, so that concurrent callers
+// with overlapping parents can not deadlock
+func lockPolicyParents(parentPaths []string) {
+	sorted := make([]string, len(parentPaths))
+	copy(sorted, parentPaths)
+	sort.Strings(sorted)
+	for _, parentPath := range sorted {
+		lockPolicyParent(parentPath)
+	}
+}
+
+func unlockPolicyParents(parentPaths []string) {
+	for _, parentPath := range parentPaths {
+		unlockPolicyParent(parentPath)
+	}
+}
@@ -0,0 +1,179 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func testKeyedMutexRefCount(k *keyedMutex, key string) int {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	entry, ok := k.locks[key]
+	if !ok {
+		return 0
+	}
+	return entry.refCount
+}
+
+func TestKeyedMutexRefCount(t *testing.T) {
+	k := newKeyedMutex()
+	k.Lock("a")
+	if count := testKeyedMutexRefCount(k, "a"); count != 1 {
+		t.Fatalf("expected ref count 1, got %d", count)
+	}
+
+	// Second locker waits, but holds a reference to the entry
+	locked := make(chan struct{})
+	go func() {
+		k.Lock("a")
+		close(locked)
+	}()
+	for testKeyedMutexRefCount(k, "a") != 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	k.Unlock("a")
+	<-locked
+	if count := testKeyedMutexRefCount(k, "a"); count != 1 {
+		t.Fatalf("expected ref count 1 after first unlock, got %d", count)
+	}
+
+	k.Unlock("a")
+	if len(k.locks) != 0 {
+		t.Fatalf("expected lock entry to be released, got %d entries", len(k.locks))
+	}
+}
+
+func TestKeyedMutexUnlockNotLocked(t *testing.T) {
+	k := newKeyedMutex()
+	// Should be logged and ignored
+	k.Unlock("a")
+	if len(k.locks) != 0 {
+		t.Fatalf("expected no lock entries, got %d", len(k.locks))
+	}
+}
+
+func TestKeyedMutexIndependentKeys(t *testing.T) {
+	k := newKeyedMutex()
+	k.Lock("a")
+	defer k.Unlock("a")
+
+	locked := make(chan struct{})
+	go func() {
+		k.Lock("b")
+		k.Unlock("b")
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lock on unrelated key is blocked")
+	}
+}
+
+func TestKeyedMutexConcurrency(t *testing.T) {
+	k := newKeyedMutex()
+	keys := []string{"a", "b", "c"}
+	counters := make(map[string]*int)
+	for _, key := range keys {
+		counters[key] = new(int)
+	}
+	active := make(map[string]int)
+	var statsMutex sync.Mutex
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for _, key := range keys {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+				k.Lock(key)
+				defer k.Unlock(key)
+
+				statsMutex.Lock()
+				active[key]++
+				if active[key] > 1 {
+					t.Errorf("concurrent holders of lock %s", key)
+				}
+				statsMutex.Unlock()
+
+				// Unprotected read-modify-write, serialized by the keyed lock only
+				counter := counters[key]
+				value := *counter
+				time.Sleep(100 * time.Microsecond)
+				*counter = value + 1
+
+				statsMutex.Lock()
+				active[key]--
+				statsMutex.Unlock()
+			}(key)
+		}
+	}
+	wg.Wait()
+
+	for _, key := range keys {
+		if *counters[key] != 50 {
+			t.Errorf("expected 50 increments for %s, got %d", key, *counters[key])
+		}
+	}
+	if len(k.locks) != 0 {
+		t.Errorf("expected all lock entries to be released, got %d entries", len(k.locks))
+	}
+}
+
+func TestLockPolicyParents(t *testing.T) {
+	pathA := "/infra/tier-0s/t0/locale-services/a"
+	pathB := "/infra/tier-0s/t0/locale-services/b"
+
+	// Overlapping parents locked in different order should not deadlock
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			lockPolicyParents([]string{pathA, pathB})
+			unlockPolicyParents([]string{pathA, pathB})
+		}()
+		go func() {
+			defer wg.Done()
+			lockPolicyParents([]string{pathB, pathA})
+			unlockPolicyParents([]string{pathB, pathA})
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("deadlock locking multiple parents")
+	}
+}
+
+func TestGetPolicyTier0LocaleServiceLockPath(t *testing.T) {
+	tests := []struct {
+		context  utl.SessionContext
+		expected string
+	}{
+		{context: utl.SessionContext{ClientType: utl.Local}, expected: "/infra/tier-0s/t0/locale-services/default"},
+		{context: utl.SessionContext{ClientType: utl.Global}, expected: "/global-infra/tier-0s/t0/locale-services/default"},
+		{context: utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: "dev"}, expected: "/orgs/default/projects/dev/infra/tier-0s/t0/locale-services/default"},
+	}
+
+	for _, test := range tests {
+		lockPath := getPolicyTier0LocaleServiceLockPath(test.context, "t0", "default")
+		if lockPath != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, lockPath)
+		}
+	}
+}
@@ -202,14 +202,20 @@ func searchGMPolicyResources(connector client.Connector, query string) ([]*data.
 }
 
 func searchLMPolicyResources(connector client.Connector, query string) ([]*data.StructValue, error) {
+	// Make sure global objects are not found (path needs to start with infra)
+	return searchAllLMPolicyResources(connector, query+" AND path:\\/infra*")
+}
+
+func searchMultitenancyPolicyResources(connector client.Connector, org string, project string, query string) ([]*data.StructValue, error) {
+	return searchAllLMPolicyResources(connector, query+fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s*", org, project))
+}
+
+func searchAllLMPolicyResources(connector client.Connector, query string) ([]*data.StructValue, error) {
 	client := lm_search.NewQueryClient(connector)
 	var results []*data.StructValue
 	var cursor *string
 	total := 0
 
-	// Make sure global objects are not found (path needs to start with infra)
-	query = query + " AND path:\\/infra*"
-
 	for {
 		searchResponse, err := client.List(query, cursor, nil, nil, nil, nil)
 		if err != nil {
@@ -227,27 +233,154 @@ func searchLMPolicyResources(connector client.Connector, query string) ([]*data.
 	}
 }
 
-func searchMultitenancyPolicyResources(connector client.Connector, org string, project string, query string) ([]*data.StructValue, error) {
-	client := lm_search.NewQueryClient(connector)
-	var results []*data.StructValue
-	var cursor *string
-	total := 0
+type policyResourceReferrer struct {
+	Path         string
+	ResourceType string
+}
 
-	query = query + fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s*", org, project)
+// List policy objects that mention objPath in any of their attributes
+func listPolicyResourceReferrers(connector client.Connector, context utl.SessionContext, objPath string) ([]policyResourceReferrer, error) {
+	var referrers []policyResourceReferrer
+	var resultValues []*data.StructValue
+	var err error
 
-	for {
-		searchResponse, err := client.List(query, cursor, nil, nil, nil, nil)
+	query := fmt.Sprintf("*:\"%s\" AND marked_for_delete:false", strings.Replace(objPath, "\"", "\\\"", -1))
+	if context.ClientType == utl.Global {
+		resultValues, err = searchGMPolicyResources(connector, query)
+	} else {
+		// Referrers are not necessarily in the same scope as the object itself,
+		// for example project objects may refer to objects under /infra
+		resultValues, err = searchAllLMPolicyResources(connector, query)
+	}
+	if err != nil {
+		return referrers, err
+	}
+
+	return getPolicyResourceReferrersFromSearch(resultValues, objPath)
+}
+
+func isPolicyPathOrChildPath(path string, objPath string) bool {
+	return path == objPath || strings.HasPrefix(path, objPath+"/")
+}
+
+func getPolicyResourceReferrersFromSearch(resultValues []*data.StructValue, objPath string) ([]policyResourceReferrer, error) {
+	var referrers []policyResourceReferrer
+	converter := bindings.NewTypeConverter()
+	for _, result := range resultValues {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return referrers, errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if policyResource.Path == nil || isPolicyPathOrChildPath(*policyResource.Path, objPath) {
+			// The object itself, as well as its children, match the search via
+			// path and parent_path attributes, and are not referrers
+			continue
+		}
+
+		referrer := policyResourceReferrer{Path: *policyResource.Path}
+		if policyResource.ResourceType != nil {
+			referrer.ResourceType = *policyResource.ResourceType
+		}
+		referrers = append(referrers, referrer)
+	}
+
+	return referrers, nil
+}
+
+// Number of object paths combined into a single referrer search, in order to keep
+// the search query within reasonable length
+const policyReferrerSearchBatchSize = 50
+
+// List policy objects that mention any of objPaths in their attributes. This allows
+// retrieving referrers of many objects with a single paged search per batch of paths,
+// rather than a search per object. Referrers are returned keyed by the referred path.
+func listPolicyResourcesReferrers(connector client.Connector, context utl.SessionContext, objPaths []string) (map[string][]policyResourceReferrer, error) {
+	referrers := make(map[string][]policyResourceReferrer)
+	for start := 0; start < len(objPaths); start += policyReferrerSearchBatchSize {
+		end := start + policyReferrerSearchBatchSize
+		if end > len(objPaths) {
+			end = len(objPaths)
+		}
+		batch := objPaths[start:end]
+
+		var terms []string
+		for _, objPath := range batch {
+			terms = append(terms, fmt.Sprintf("\"%s\"", strings.Replace(objPath, "\"", "\\\"", -1)))
+		}
+		query := fmt.Sprintf("*:(%s) AND marked_for_delete:false", strings.Join(terms, " OR "))
+
+		var resultValues []*data.StructValue
+		var err error
+		if context.ClientType == utl.Global {
+			resultValues, err = searchGMPolicyResources(connector, query)
+		} else {
+			resultValues, err = searchAllLMPolicyResources(connector, query)
+		}
 		if err != nil {
-			return results, err
+			return referrers, err
 		}
-		results = append(results, searchResponse.Results...)
-		if total == 0 {
-			// first response
-			total = int(*searchResponse.ResultCount)
+
+		err = addPolicyResourcesReferrersFromSearch(referrers, resultValues, batch)
+		if err != nil {
+			return referrers, err
 		}
-		cursor = searchResponse.Cursor
-		if len(results) >= total {
-			return results, nil
+	}
+
+	return referrers, nil
+}
+
+// addPolicyResourcesReferrersFromSearch matches search results against objPaths locally,
+// since search results do not indicate which of the searched paths was found
+func addPolicyResourcesReferrersFromSearch(referrers map[string][]policyResourceReferrer, resultValues []*data.StructValue, objPaths []string) error {
+	pathSet := make(map[string]bool)
+	for _, objPath := range objPaths {
+		pathSet[objPath] = true
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, result := range resultValues {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if policyResource.Path == nil {
+			continue
+		}
+
+		referrer := policyResourceReferrer{Path: *policyResource.Path}
+		if policyResource.ResourceType != nil {
+			referrer.ResourceType = *policyResource.ResourceType
+		}
+
+		found := make(map[string]bool)
+		collectPolicyDataStringValues(result, func(value string) {
+			if pathSet[value] && !isPolicyPathOrChildPath(referrer.Path, value) && !found[value] {
+				found[value] = true
+				referrers[value] = append(referrers[value], referrer)
+			}
+		})
+	}
+
+	return nil
+}
+
+func collectPolicyDataStringValues(value data.DataValue, collect func(string)) {
+	switch v := value.(type) {
+	case *data.StringValue:
+		collect(v.Value())
+	case *data.OptionalValue:
+		if v.IsSet() {
+			collectPolicyDataStringValues(v.Value(), collect)
+		}
+	case *data.ListValue:
+		for _, elem := range v.List() {
+			collectPolicyDataStringValues(elem, collect)
+		}
+	case *data.StructValue:
+		for _, field := range v.Fields() {
+			collectPolicyDataStringValues(field, collect)
 		}
 	}
 }
@@ -170,6 +170,18 @@ func setPathListInSchema(d *schema.ResourceData, attrName string, pathList []str
 	}
 }
 
+// Returns policy path of the infra root for session context, such as
+// /orgs/default/projects/dev/infra for multitenancy
+func getPolicyInfraPath(context utl.SessionContext) string {
+	switch context.ClientType {
+	case utl.Multitenancy:
+		return fmt.Sprintf("/orgs/%s/projects/%s/infra", utl.DefaultOrgID, context.ProjectID)
+	case utl.Global:
+		return "/global-infra"
+	}
+	return "/infra"
+}
+
 func getDomainFromResourcePath(rPath string) string {
 	return getResourceIDFromResourcePath(rPath, "domains")
 }
@@ -188,6 +200,18 @@ func getResourceIDFromResourcePath(rPath string, rType string) string {
 	return ""
 }
 
+// Returns path of the ancestor object of given type, for example gateway path
+// for rType "tier-0s" and path of an object under that gateway
+func getAncestorPathFromResourcePath(rPath string, rType string) (string, error) {
+	segments := strings.Split(rPath, "/")
+	for i, seg := range segments {
+		if seg == rType && i+1 < len(segments) {
+			return strings.Join(segments[:i+2], "/"), nil
+		}
+	}
+	return "", fmt.Errorf("failed to find %s in policy path %s", rType, rPath)
+}
+
 func nsxtDomainResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importDomain := defaultDomain
 	importID := d.Id()
@@ -250,7 +274,10 @@ func nsxtPolicyPathResourceImporterHelper(d *schema.ResourceData, m interface{})
 			ctxMap := make(map[string]interface{})
 			ctxMap["project_id"] = pathSegs[4]
 			contexts[0] = ctxMap
-			d.Set("context", contexts)
+			if err := d.Set("context", contexts); err != nil {
+				// Resource does not support multitenancy
+				return nil, fmt.Errorf("project path %s is not supported for import of this resource", importID)
+			}
 			d.SetId(pathSegs[len(pathSegs)-1])
 		}
 		return []*schema.ResourceData{d}, nil
@@ -400,6 +427,79 @@ func retryUponPreconditionFailed(readAndUpdate func() error, maxRetryAttempts in
 	return err
 }
 
+// Delete policy object using doDelete, and in case deletion fails while the object
+// is still referenced by other objects, report those referrers in the error.
+// If delete_referrers_timeout is configured in the provider, deletion is retried
+// until referrers are gone, which is useful when referrers are being destroyed
+// in the same apply.
+func policyDeleteWithReferrersCheck(d *schema.ResourceData, m interface{}, resourceType string, doDelete func() error) error {
+	objPath := d.Get("path").(string)
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	listReferrers := func() ([]policyResourceReferrer, error) {
+		return listPolicyResourceReferrers(connector, context, objPath)
+	}
+
+	timeout := getCommonProviderConfig(m).DeleteReferrersTimeout
+	return policyDeleteWithReferrersRetry(resourceType, d.Id(), objPath, timeout, doDelete, listReferrers)
+}
+
+func policyDeleteWithReferrersRetry(resourceType string, id string, objPath string, timeout int, doDelete func() error, listReferrers func() ([]policyResourceReferrer, error)) error {
+	err := doDelete()
+	if err == nil {
+		return nil
+	}
+	if isNotFoundError(err) || objPath == "" {
+		return handleDeleteError(resourceType, id, err)
+	}
+
+	referrers, searchErr := listReferrers()
+	if searchErr != nil {
+		log.Printf("[WARNING] Failed to retrieve referrers for %s: %v", objPath, searchErr)
+		return handleDeleteError(resourceType, id, err)
+	}
+	if len(referrers) == 0 {
+		return handleDeleteError(resourceType, id, err)
+	}
+
+	if timeout > 0 {
+		log.Printf("[INFO] %s %s is referenced by %d objects, retrying deletion for up to %d seconds", resourceType, id, len(referrers), timeout)
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"referenced"},
+			Target:  []string{"deleted"},
+			Refresh: func() (interface{}, string, error) {
+				currentReferrers, searchErr := listReferrers()
+				if searchErr != nil {
+					return nil, "", searchErr
+				}
+				if len(currentReferrers) > 0 {
+					referrers = currentReferrers
+					log.Printf("[DEBUG] %s %s is still referenced by %d objects", resourceType, id, len(referrers))
+					return referrers, "referenced", nil
+				}
+
+				err = doDelete()
+				if err == nil || isNotFoundError(err) {
+					return referrers, "deleted", nil
+				}
+				// Search index might lag behind actual references
+				log.Printf("[DEBUG] Failed to delete %s %s: %v", resourceType, id, err)
+				return referrers, "referenced", nil
+			},
+			Timeout:    time.Duration(timeout) * time.Second,
+			MinTimeout: 1 * time.Second,
+			Delay:      1 * time.Second,
+		}
+		_, waitErr := stateConf.WaitForState()
+		if waitErr == nil {
+			return nil
+		}
+		log.Printf("[WARNING] Failed to wait for referrers of %s %s to be removed: %v", resourceType, id, waitErr)
+	}
+
+	return handleDeleteReferrersError(resourceType, id, referrers, err)
+}
+
 func getElemOrEmptyMapFromSchema(d *schema.ResourceData, key string) map[string]interface{} {
 	e := d.Get(key)
 	if e != nil {
@@ -456,3 +556,11 @@ func getPolicyLbMonitorPortSchema() *schema.Schema {
 		Optional:    true,
 	}
 }
+
+// NSX timestamps are milliseconds since epoch, they are exposed in RFC3339 format
+func policyTimestampToString(timestamp *int64) string {
+	if timestamp == nil {
+		return ""
+	}
+	return time.UnixMilli(*timestamp).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,291 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkerrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const testReferrerObjPath = "/infra/domains/default/groups/g1"
+
+var testReferrer = policyResourceReferrer{
+	Path:         "/infra/domains/default/security-policies/p1/rules/r1",
+	ResourceType: "Rule",
+}
+
+func TestPolicyDeleteWithReferrersRetry(t *testing.T) {
+	// NSX reports deletion of referenced object as invalid request
+	inUseErr := sdkerrors.InvalidRequest{}
+
+	tests := []struct {
+		name string
+		// results of consecutive delete attempts, the last one repeats
+		deleteResults []error
+		// results of consecutive referrer searches, the last one repeats
+		referrers    [][]policyResourceReferrer
+		searchErr    error
+		objPath      string
+		timeout      int
+		expectErr    bool
+		expectInErr  string
+		expectSearch bool
+	}{
+		{
+			name:          "deleted",
+			deleteResults: []error{nil},
+			objPath:       testReferrerObjPath,
+		},
+		{
+			name:          "no path",
+			deleteResults: []error{inUseErr},
+			expectErr:     true,
+		},
+		{
+			name:          "no referrers",
+			deleteResults: []error{inUseErr},
+			referrers:     [][]policyResourceReferrer{nil},
+			objPath:       testReferrerObjPath,
+			expectErr:     true,
+			expectSearch:  true,
+		},
+		{
+			name:          "search failure",
+			deleteResults: []error{inUseErr},
+			searchErr:     errors.New("search failed"),
+			objPath:       testReferrerObjPath,
+			expectErr:     true,
+			expectInErr:   "Failed to delete",
+			expectSearch:  true,
+		},
+		{
+			name:          "referrers reported without retry",
+			deleteResults: []error{inUseErr},
+			referrers:     [][]policyResourceReferrer{{testReferrer}},
+			objPath:       testReferrerObjPath,
+			expectErr:     true,
+			expectInErr:   testReferrer.Path,
+			expectSearch:  true,
+		},
+		{
+			name:          "referrers removed during retry",
+			deleteResults: []error{inUseErr, nil},
+			referrers:     [][]policyResourceReferrer{{testReferrer}, {testReferrer}, nil},
+			objPath:       testReferrerObjPath,
+			timeout:       10,
+			expectSearch:  true,
+		},
+		{
+			name:          "retry timeout expired",
+			deleteResults: []error{inUseErr},
+			referrers:     [][]policyResourceReferrer{{testReferrer}},
+			objPath:       testReferrerObjPath,
+			timeout:       2,
+			expectErr:     true,
+			expectInErr:   testReferrer.Path,
+			expectSearch:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deleteCount := 0
+			doDelete := func() error {
+				idx := deleteCount
+				if idx >= len(test.deleteResults) {
+					idx = len(test.deleteResults) - 1
+				}
+				deleteCount++
+				return test.deleteResults[idx]
+			}
+			searchCount := 0
+			listReferrers := func() ([]policyResourceReferrer, error) {
+				if test.searchErr != nil {
+					searchCount++
+					return nil, test.searchErr
+				}
+				idx := searchCount
+				if idx >= len(test.referrers) {
+					idx = len(test.referrers) - 1
+				}
+				searchCount++
+				return test.referrers[idx], nil
+			}
+
+			err := policyDeleteWithReferrersRetry("Group", "g1", test.objPath, test.timeout, doDelete, listReferrers)
+			if test.expectErr && err == nil {
+				t.Fatalf("expected error, got none")
+			}
+			if !test.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.expectInErr != "" && !strings.Contains(err.Error(), test.expectInErr) {
+				t.Errorf("expected error to contain %s, got: %v", test.expectInErr, err)
+			}
+			if test.expectSearch != (searchCount > 0) {
+				t.Errorf("expected search to be called: %v, search count: %d", test.expectSearch, searchCount)
+			}
+		})
+	}
+}
+
+func testPolicyResourceStructValue(t *testing.T, path string, resourceType string) *data.StructValue {
+	obj := model.PolicyResource{
+		Path:         &path,
+		ResourceType: &resourceType,
+	}
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(obj, model.PolicyResourceBindingType())
+	if len(errs) > 0 {
+		t.Fatal(errs[0])
+	}
+	return dataValue.(*data.StructValue)
+}
+
+func TestGetPolicyResourceReferrersFromSearch(t *testing.T) {
+	results := []*data.StructValue{
+		testPolicyResourceStructValue(t, testReferrerObjPath, "Group"),
+		testPolicyResourceStructValue(t, testReferrer.Path, testReferrer.ResourceType),
+	}
+
+	referrers, err := getPolicyResourceReferrersFromSearch(results, testReferrerObjPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The object itself should not be reported as its own referrer
+	if len(referrers) != 1 {
+		t.Fatalf("expected 1 referrer, got %d", len(referrers))
+	}
+	if referrers[0] != testReferrer {
+		t.Errorf("expected referrer %v, got %v", testReferrer, referrers[0])
+	}
+
+	// Children of the object match the search via parent_path, and should not be
+	// reported as referrers either
+	servicePath := "/infra/services/s1"
+	serviceReferrer := policyResourceReferrer{
+		Path:         "/infra/domains/default/gateway-policies/p1/rules/r1",
+		ResourceType: "Rule",
+	}
+	results = []*data.StructValue{
+		testPolicyResourceStructValue(t, servicePath, "Service"),
+		testPolicyResourceStructValue(t, servicePath+"/service-entries/e1", "L4PortSetServiceEntry"),
+		testPolicyResourceStructValue(t, servicePath+"1", "Service"),
+		testPolicyResourceStructValue(t, serviceReferrer.Path, serviceReferrer.ResourceType),
+	}
+
+	referrers, err = getPolicyResourceReferrersFromSearch(results, servicePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []policyResourceReferrer{
+		{Path: servicePath + "1", ResourceType: "Service"},
+		serviceReferrer,
+	}
+	if !reflect.DeepEqual(referrers, expected) {
+		t.Errorf("expected referrers %v, got %v", expected, referrers)
+	}
+}
+
+func TestNsxtPolicyPathResourceImporterHelper(t *testing.T) {
+	withContext := map[string]*schema.Schema{
+		"context": getContextSchema(),
+	}
+	withoutContext := map[string]*schema.Schema{
+		"display_name": getDisplayNameSchema(),
+	}
+
+	tests := []struct {
+		name          string
+		schema        map[string]*schema.Schema
+		importID      string
+		expectErr     bool
+		expectID      string
+		expectProject string
+	}{
+		{name: "infra path", schema: withContext, importID: "/infra/tier-1s/t1/locale-services/default/ipsec-vpn-services/s1", expectID: "s1"},
+		{name: "project path", schema: withContext, importID: "/orgs/default/projects/p1/infra/domains/default/groups/g1", expectID: "g1", expectProject: "p1"},
+		{name: "project path without context", schema: withoutContext, importID: "/orgs/default/projects/p1/infra/tier-1s/t1/locale-services/default/tunnels/g1", expectErr: true},
+		{name: "not a path", schema: withContext, importID: "g1", expectErr: true, expectID: "g1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, test.schema, map[string]interface{}{})
+			d.SetId(test.importID)
+			_, err := nsxtPolicyPathResourceImporterHelper(d, nil)
+			if test.expectErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectErr, err)
+			}
+			if test.expectID != "" && d.Id() != test.expectID {
+				t.Errorf("expected ID %s, got %s", test.expectID, d.Id())
+			}
+			if test.expectProject != "" {
+				projectID := d.Get("context.0.project_id").(string)
+				if projectID != test.expectProject {
+					t.Errorf("expected project %s, got %s", test.expectProject, projectID)
+				}
+			}
+		})
+	}
+}
+
+func TestAddPolicyResourcesReferrersFromSearch(t *testing.T) {
+	certPath := "/infra/certificates/cert1"
+	otherCertPath := "/infra/certificates/cert10"
+	unusedCertPath := "/infra/certificates/cert2"
+
+	withReference := func(path string, resourceType string, refs ...string) *data.StructValue {
+		result := testPolicyResourceStructValue(t, path, resourceType)
+		list := data.NewListValue()
+		for _, ref := range refs {
+			list.Add(data.NewStringValue(ref))
+		}
+		result.SetField("certificate_paths", data.NewOptionalValue(list))
+		return result
+	}
+
+	results := []*data.StructValue{
+		withReference("/infra/lb-client-ssl-profiles/p1", "LBClientSslProfile", certPath),
+		withReference("/infra/lb-virtual-servers/vs1", "LBVirtualServer", certPath, otherCertPath, certPath),
+		// Certificate itself is returned by search as well
+		testPolicyResourceStructValue(t, certPath, "TlsCertificate"),
+	}
+
+	referrers := make(map[string][]policyResourceReferrer)
+	err := addPolicyResourcesReferrersFromSearch(referrers, results, []string{certPath, otherCertPath, unusedCertPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]policyResourceReferrer{
+		certPath: {
+			{Path: "/infra/lb-client-ssl-profiles/p1", ResourceType: "LBClientSslProfile"},
+			{Path: "/infra/lb-virtual-servers/vs1", ResourceType: "LBVirtualServer"},
+		},
+		otherCertPath: {
+			{Path: "/infra/lb-virtual-servers/vs1", ResourceType: "LBVirtualServer"},
+		},
+	}
+	if !reflect.DeepEqual(referrers, expected) {
+		t.Errorf("expected referrers %v, got %v", expected, referrers)
+	}
+}
+
+func TestPolicyTimestampToString(t *testing.T) {
+	timestamp := int64(1700000000123)
+	if result := policyTimestampToString(&timestamp); result != "2023-11-14T22:13:20Z" {
+		t.Errorf("unexpected timestamp string %s", result)
+	}
+	if result := policyTimestampToString(nil); result != "" {
+		t.Errorf("expected empty string for nil timestamp, got %s", result)
+	}
+}
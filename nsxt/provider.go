@@ -46,6 +46,7 @@ type commonProviderConfig struct {
 	Username               string
 	Password               string
 	LicenseKeys            []string
+	DeleteReferrersTimeout int
 }
 
 type nsxtClients struct {
@@ -206,13 +207,11 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeList,
 				Optional:      true,
 				Description:   "license keys",
+				Deprecated:    "Use nsxt_license resource instead",
 				ConflictsWith: []string{"vmc_token", "vmc_client_id", "vmc_client_secret"},
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateFunc: validation.StringMatch(
-						regexp.MustCompile(
-							"^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$"),
-						"Must be a valid nsx license key matching: ^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$"),
+					Type:         schema.TypeString,
+					ValidateFunc: validateLicenseKey(),
 				},
 			},
 			"client_auth_cert": {
@@ -239,6 +238,13 @@ func Provider() *schema.Provider {
 				Description: "Avoid initializing NSX connection on startup",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_ON_DEMAND_CONNECTION", false),
 			},
+			"delete_referrers_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Timeout in seconds to keep retrying deletion of policy objects that are still referenced by other objects",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_DELETE_REFERRERS_TIMEOUT", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -280,11 +286,14 @@ func Provider() *schema.Provider {
 			"nsxt_policy_lb_server_ssl_profile":         dataSourceNsxtPolicyLBServerSslProfile(),
 			"nsxt_policy_lb_monitor":                    dataSourceNsxtPolicyLBMonitor(),
 			"nsxt_policy_certificate":                   dataSourceNsxtPolicyCertificate(),
+			"nsxt_policy_certificates":                  dataSourceNsxtPolicyCertificates(),
+			"nsxt_policy_firewall_drafts":               dataSourceNsxtPolicyFirewallDrafts(),
 			"nsxt_policy_lb_persistence_profile":        dataSourceNsxtPolicyLbPersistenceProfile(),
 			"nsxt_policy_vni_pool":                      dataSourceNsxtPolicyVniPool(),
 			"nsxt_policy_ip_block":                      dataSourceNsxtPolicyIPBlock(),
 			"nsxt_policy_ip_pool":                       dataSourceNsxtPolicyIPPool(),
 			"nsxt_policy_site":                          dataSourceNsxtPolicySite(),
+			"nsxt_policy_object":                        dataSourceNsxtPolicyObject(),
 			"nsxt_policy_gateway_policy":                dataSourceNsxtPolicyGatewayPolicy(),
 			"nsxt_policy_security_policy":               dataSourceNsxtPolicySecurityPolicy(),
 			"nsxt_policy_group":                         dataSourceNsxtPolicyGroup(),
@@ -293,12 +302,16 @@ func Provider() *schema.Provider {
 			"nsxt_policy_bfd_profile":                   dataSourceNsxtPolicyBfdProfile(),
 			"nsxt_policy_intrusion_service_profile":     dataSourceNsxtPolicyIntrusionServiceProfile(),
 			"nsxt_policy_lb_service":                    dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_lb_service_status":             dataSourceNsxtPolicyLbServiceStatus(),
+			"nsxt_policy_lb_virtual_server_status":      dataSourceNsxtPolicyLbVirtualServerStatus(),
+			"nsxt_policy_lb_pool_status":                dataSourceNsxtPolicyLbPoolStatus(),
 			"nsxt_policy_gateway_locale_service":        dataSourceNsxtPolicyGatewayLocaleService(),
 			"nsxt_policy_bridge_profile":                dataSourceNsxtPolicyBridgeProfile(),
 			"nsxt_policy_ipsec_vpn_local_endpoint":      dataSourceNsxtPolicyIPSecVpnLocalEndpoint(),
 			"nsxt_policy_ipsec_vpn_service":             dataSourceNsxtPolicyIPSecVpnService(),
 			"nsxt_policy_l2_vpn_service":                dataSourceNsxtPolicyL2VpnService(),
 			"nsxt_policy_segment":                       dataSourceNsxtPolicySegment(),
+			"nsxt_policy_segment_port":                  dataSourceNsxtPolicySegmentPort(),
 			"nsxt_policy_project":                       dataSourceNsxtPolicyProject(),
 			"nsxt_policy_gateway_dns_forwarder":         dataSourceNsxtPolicyGatewayDNSForwarder(),
 			"nsxt_policy_gateway_prefix_list":           dataSourceNsxtPolicyGatewayPrefixList(),
@@ -391,10 +404,12 @@ func Provider() *schema.Provider {
 			"nsxt_policy_security_policy":                  resourceNsxtPolicySecurityPolicy(),
 			"nsxt_policy_service":                          resourceNsxtPolicyService(),
 			"nsxt_policy_gateway_policy":                   resourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_gateway_policy_rule":              resourceNsxtPolicyGatewayPolicyRule(),
 			"nsxt_policy_predefined_gateway_policy":        resourceNsxtPolicyPredefinedGatewayPolicy(),
 			"nsxt_policy_predefined_security_policy":       resourceNsxtPolicyPredefinedSecurityPolicy(),
 			"nsxt_policy_segment":                          resourceNsxtPolicySegment(),
 			"nsxt_policy_vlan_segment":                     resourceNsxtPolicyVlanSegment(),
+			"nsxt_policy_segment_port":                     resourceNsxtPolicySegmentPort(),
 			"nsxt_policy_fixed_segment":                    resourceNsxtPolicyFixedSegment(),
 			"nsxt_policy_static_route":                     resourceNsxtPolicyStaticRoute(),
 			"nsxt_policy_gateway_prefix_list":              resourceNsxtPolicyGatewayPrefixList(),
@@ -402,16 +417,19 @@ func Provider() *schema.Provider {
 			"nsxt_policy_nat_rule":                         resourceNsxtPolicyNATRule(),
 			"nsxt_policy_ip_block":                         resourceNsxtPolicyIPBlock(),
 			"nsxt_policy_lb_pool":                          resourceNsxtPolicyLBPool(),
+			"nsxt_policy_lb_pool_member":                   resourceNsxtPolicyLBPoolMember(),
 			"nsxt_policy_ip_pool":                          resourceNsxtPolicyIPPool(),
 			"nsxt_policy_ip_pool_block_subnet":             resourceNsxtPolicyIPPoolBlockSubnet(),
 			"nsxt_policy_ip_pool_static_subnet":            resourceNsxtPolicyIPPoolStaticSubnet(),
 			"nsxt_policy_lb_service":                       resourceNsxtPolicyLBService(),
 			"nsxt_policy_lb_virtual_server":                resourceNsxtPolicyLBVirtualServer(),
+			"nsxt_policy_lb_virtual_server_rule":           resourceNsxtPolicyLBVirtualServerRule(),
 			"nsxt_policy_ip_address_allocation":            resourceNsxtPolicyIPAddressAllocation(),
 			"nsxt_policy_bgp_neighbor":                     resourceNsxtPolicyBgpNeighbor(),
 			"nsxt_policy_bgp_config":                       resourceNsxtPolicyBgpConfig(),
 			"nsxt_policy_dhcp_relay":                       resourceNsxtPolicyDhcpRelayConfig(),
 			"nsxt_policy_dhcp_server":                      resourceNsxtPolicyDhcpServer(),
+			"nsxt_policy_metadata_proxy":                   resourceNsxtPolicyMetadataProxy(),
 			"nsxt_policy_context_profile":                  resourceNsxtPolicyContextProfile(),
 			"nsxt_policy_dhcp_v4_static_binding":           resourceNsxtPolicyDhcpV4StaticBinding(),
 			"nsxt_policy_dhcp_v6_static_binding":           resourceNsxtPolicyDhcpV6StaticBinding(),
@@ -419,6 +437,7 @@ func Provider() *schema.Provider {
 			"nsxt_policy_gateway_dns_forwarder":            resourceNsxtPolicyGatewayDNSForwarder(),
 			"nsxt_policy_gateway_community_list":           resourceNsxtPolicyGatewayCommunityList(),
 			"nsxt_policy_gateway_route_map":                resourceNsxtPolicyGatewayRouteMap(),
+			"nsxt_policy_tier0_inter_vrf_routing":          resourceNsxtPolicyTier0InterVrfRouting(),
 			"nsxt_policy_intrusion_service_policy":         resourceNsxtPolicyIntrusionServicePolicy(),
 			"nsxt_policy_static_route_bfd_peer":            resourceNsxtPolicyStaticRouteBfdPeer(),
 			"nsxt_policy_intrusion_service_profile":        resourceNsxtPolicyIntrusionServiceProfile(),
@@ -430,6 +449,9 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ospf_config":                      resourceNsxtPolicyOspfConfig(),
 			"nsxt_policy_ospf_area":                        resourceNsxtPolicyOspfArea(),
 			"nsxt_policy_gateway_redistribution_config":    resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_policy_gateway_multicast_config":         resourceNsxtPolicyGatewayMulticastConfig(),
+			"nsxt_policy_pim_profile":                      resourceNsxtPolicyPimProfile(),
+			"nsxt_policy_igmp_profile":                     resourceNsxtPolicyIgmpProfile(),
 			"nsxt_policy_mac_discovery_profile":            resourceNsxtPolicyMacDiscoveryProfile(),
 			"nsxt_policy_ipsec_vpn_ike_profile":            resourceNsxtPolicyIPSecVpnIkeProfile(),
 			"nsxt_policy_ipsec_vpn_tunnel_profile":         resourceNsxtPolicyIPSecVpnTunnelProfile(),
@@ -444,8 +466,12 @@ func Provider() *schema.Provider {
 			"nsxt_policy_segment_security_profile":         resourceNsxtPolicySegmentSecurityProfile(),
 			"nsxt_policy_spoof_guard_profile":              resourceNsxtPolicySpoofGuardProfile(),
 			"nsxt_policy_gateway_qos_profile":              resourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_bfd_profile":                      resourceNsxtPolicyBfdProfile(),
+			"nsxt_policy_ipv6_ndra_profile":                resourceNsxtPolicyIpv6NdraProfile(),
+			"nsxt_policy_ipv6_dad_profile":                 resourceNsxtPolicyIpv6DadProfile(),
 			"nsxt_policy_project":                          resourceNsxtPolicyProject(),
 			"nsxt_policy_transport_zone":                   resourceNsxtPolicyTransportZone(),
+			"nsxt_policy_edge_bridge_profile":              resourceNsxtPolicyEdgeBridgeProfile(),
 			"nsxt_policy_user_management_role":             resourceNsxtPolicyUserManagementRole(),
 			"nsxt_policy_user_management_role_binding":     resourceNsxtPolicyUserManagementRoleBinding(),
 			"nsxt_policy_ldap_identity_source":             resourceNsxtPolicyLdapIdentitySource(),
@@ -458,15 +484,25 @@ func Provider() *schema.Provider {
 			"nsxt_edge_transport_node":                     resourceNsxtEdgeTransportNode(),
 			"nsxt_failure_domain":                          resourceNsxtFailureDomain(),
 			"nsxt_cluster_virtual_ip":                      resourceNsxtClusterVirualIP(),
+			"nsxt_license":                                 resourceNsxtLicense(),
 			"nsxt_policy_host_transport_node_profile":      resourceNsxtPolicyHostTransportNodeProfile(),
 			"nsxt_policy_host_transport_node":              resourceNsxtPolicyHostTransportNode(),
 			"nsxt_edge_high_availability_profile":          resourceNsxtEdgeHighAvailabilityProfile(),
 			"nsxt_policy_host_transport_node_collection":   resourceNsxtPolicyHostTransportNodeCollection(),
 			"nsxt_policy_lb_client_ssl_profile":            resourceNsxtPolicyLBClientSslProfile(),
+			"nsxt_policy_lb_server_ssl_profile":            resourceNsxtPolicyLBServerSslProfile(),
+			"nsxt_policy_lb_cookie_persistence_profile":    resourceNsxtPolicyLBCookiePersistenceProfile(),
+			"nsxt_policy_lb_source_ip_persistence_profile": resourceNsxtPolicyLBSourceIPPersistenceProfile(),
+			"nsxt_policy_lb_generic_persistence_profile":   resourceNsxtPolicyLBGenericPersistenceProfile(),
 			"nsxt_policy_lb_http_application_profile":      resourceNsxtPolicyLBHttpApplicationProfile(),
+			"nsxt_policy_lb_fast_tcp_application_profile":  resourceNsxtPolicyLBFastTcpApplicationProfile(),
+			"nsxt_policy_lb_fast_udp_application_profile":  resourceNsxtPolicyLBFastUdpApplicationProfile(),
 			"nsxt_policy_security_policy_rule":             resourceNsxtPolicySecurityPolicyRule(),
 			"nsxt_policy_parent_security_policy":           resourceNsxtPolicyParentSecurityPolicy(),
+			"nsxt_policy_parent_gateway_policy":            resourceNsxtPolicyParentGatewayPolicy(),
 			"nsxt_policy_firewall_exclude_list_member":     resourceNsxtPolicyFirewallExcludeListMember(),
+			"nsxt_policy_firewall_draft":                   resourceNsxtPolicyFirewallDraft(),
+			"nsxt_policy_firewall_draft_publish":           resourceNsxtPolicyFirewallDraftPublish(),
 			"nsxt_policy_lb_http_monitor_profile":          resourceNsxtPolicyLBHttpMonitorProfile(),
 			"nsxt_policy_lb_https_monitor_profile":         resourceNsxtPolicyLBHttpsMonitorProfile(),
 			"nsxt_policy_lb_icmp_monitor_profile":          resourceNsxtPolicyLBIcmpMonitorProfile(),
@@ -479,6 +515,12 @@ func Provider() *schema.Provider {
 			"nsxt_upgrade_precheck_acknowledge":            resourceNsxtUpgradePrecheckAcknowledge(),
 			"nsxt_policy_vtep_ha_host_switch_profile":      resourceNsxtVtepHAHostSwitchProfile(),
 			"nsxt_policy_site":                             resourceNsxtPolicySite(),
+			"nsxt_policy_generic":                          resourceNsxtPolicyGeneric(),
+			"nsxt_policy_certificate":                      resourceNsxtPolicyCertificate(),
+			"nsxt_policy_csr":                              resourceNsxtPolicyCsr(),
+			"nsxt_policy_self_signed_certificate":          resourceNsxtPolicySelfSignedCertificate(),
+			"nsxt_policy_crl":                              resourceNsxtPolicyCrl(),
+			"nsxt_certificate_service_binding":             resourceNsxtCertificateServiceBinding(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -1020,6 +1062,7 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	retryMaxDelay := d.Get("retry_max_delay").(int)
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
+	deleteReferrersTimeout := d.Get("delete_referrers_timeout").(int)
 
 	statuses := d.Get("retry_on_status_codes").([]interface{})
 	retryStatuses := make([]int, 0, len(statuses))
@@ -1043,6 +1086,7 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		Username:               username,
 		Password:               password,
 		LicenseKeys:            licenses,
+		DeleteReferrersTimeout: deleteReferrersTimeout,
 	}
 }
 
@@ -1164,7 +1208,7 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 		initNSXVersion(connector)
 		err := configureLicenses(connector, c.CommonConfig.LicenseKeys)
 		if err != nil {
-			log.Printf("[ERROR]: Failed to apply NSX licenses")
+			log.Printf("[ERROR]: Failed to apply NSX licenses: %v", err)
 		}
 	}
 	return connector
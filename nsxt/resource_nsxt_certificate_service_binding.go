@@ -0,0 +1,230 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/trust_management"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var certificateServiceTypeValues = []string{
+	trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_API,
+	trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_MGMT_CLUSTER,
+}
+
+func resourceNsxtCertificateServiceBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtCertificateServiceBindingCreate,
+		Read:   resourceNsxtCertificateServiceBindingRead,
+		Update: resourceNsxtCertificateServiceBindingUpdate,
+		Delete: resourceNsxtCertificateServiceBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtCertificateServiceBindingImport,
+		},
+
+		Schema: addCertificateDetailsSchema(map[string]*schema.Schema{
+			"certificate_path": getPolicyPathSchema(true, false, "Policy path of the certificate to apply"),
+			"service_type": {
+				Type:         schema.TypeString,
+				Description:  "Service the certificate is applied to",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(certificateServiceTypeValues, false),
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "Manager node to apply the certificate on, required when certificate is not applied cluster-wide",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		}),
+	}
+}
+
+func applyCertificateToService(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := trust_management.NewCertificatesClient(connector)
+
+	certID := getPolicyIDFromPath(d.Get("certificate_path").(string))
+	serviceType := d.Get("service_type").(string)
+	var nodeID *string
+	if node := d.Get("node_id").(string); node != "" {
+		nodeID = &node
+	}
+
+	log.Printf("[INFO] Applying Certificate %s to service %s", certID, serviceType)
+	return client.Applycertificate(certID, serviceType, nodeID)
+}
+
+func resourceNsxtCertificateServiceBindingCreate(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("service_type").(string)
+	nodeID := d.Get("node_id").(string)
+	if nodeID != "" {
+		id = fmt.Sprintf("%s/%s", id, nodeID)
+	} else if id == trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_API {
+		return fmt.Errorf("node_id is required for service_type %s", id)
+	}
+
+	err := applyCertificateToService(d, m)
+	if err != nil {
+		return handleCreateError("CertificateServiceBinding", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtCertificateServiceBindingRead(d, m)
+}
+
+// Certificate applied to API service of a node is only reported by its thumbprint, hence
+// it is matched against the current certificate first, and against all certificates otherwise
+func getNodeAPICertificatePath(connector client.Connector, nodeID string, currentPath string) (string, error) {
+	nodesClient := cluster.NewNodesClient(connector)
+	node, err := nodesClient.Get(nodeID)
+	if err != nil {
+		return "", err
+	}
+	if node.ManagerRole == nil || node.ManagerRole.ApiListenAddr == nil || node.ManagerRole.ApiListenAddr.CertificateSha256Thumbprint == nil {
+		return currentPath, nil
+	}
+	thumbprint := *node.ManagerRole.ApiListenAddr.CertificateSha256Thumbprint
+
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	if currentPath != "" {
+		cert, err := client.Get(getPolicyIDFromPath(currentPath), &details)
+		if err == nil && isCertificateThumbprint(cert, thumbprint) {
+			return currentPath, nil
+		}
+		if err != nil && !isNotFoundError(err) {
+			return "", err
+		}
+	}
+
+	certificates, err := listPolicyCertificates(connector)
+	if err != nil {
+		return "", err
+	}
+	for _, cert := range certificates {
+		if cert.Path != nil && isCertificateThumbprint(cert, thumbprint) {
+			return *cert.Path, nil
+		}
+	}
+
+	// Certificate in use is not known to policy API
+	return "", nil
+}
+
+func isCertificateThumbprint(cert model.TlsCertificate, thumbprint string) bool {
+	if len(cert.Details) == 0 || cert.Details[0].Sha256Thumbprint == nil {
+		return false
+	}
+	return strings.EqualFold(*cert.Details[0].Sha256Thumbprint, thumbprint)
+}
+
+func resourceNsxtCertificateServiceBindingRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CertificateServiceBinding ID")
+	}
+
+	certPath := d.Get("certificate_path").(string)
+	if d.Get("service_type").(string) == trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_MGMT_CLUSTER {
+		clusterClient := cluster.NewApiCertificateClient(connector)
+		applied, err := clusterClient.Get()
+		if err != nil {
+			return handleReadError(d, "CertificateServiceBinding", id, err)
+		}
+		if applied.CertificateId != nil && *applied.CertificateId != getPolicyIDFromPath(certPath) {
+			certPath = fmt.Sprintf("/infra/certificates/%s", *applied.CertificateId)
+		}
+	} else if nodeID := d.Get("node_id").(string); nodeID != "" {
+		appliedPath, err := getNodeAPICertificatePath(connector, nodeID, certPath)
+		if err != nil {
+			return handleReadError(d, "CertificateServiceBinding", id, err)
+		}
+		certPath = appliedPath
+	}
+	d.Set("certificate_path", certPath)
+	if certPath == "" {
+		return nil
+	}
+
+	certID := getPolicyIDFromPath(certPath)
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	cert, err := client.Get(certID, &details)
+	if err != nil {
+		return handleReadError(d, "CertificateServiceBinding", id, err)
+	}
+	setCertificateDetailsInSchema(d, cert.Details)
+
+	return nil
+}
+
+func resourceNsxtCertificateServiceBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+
+	err := applyCertificateToService(d, m)
+	if err != nil {
+		return handleUpdateError("CertificateServiceBinding", id, err)
+	}
+
+	return resourceNsxtCertificateServiceBindingRead(d, m)
+}
+
+func resourceNsxtCertificateServiceBindingDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CertificateServiceBinding ID")
+	}
+
+	if d.Get("service_type").(string) != trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_MGMT_CLUSTER {
+		// A service always needs a certificate, hence certificate can only be replaced
+		log.Printf("[INFO] Certificate applied to %s remains in use on NSX", id)
+		return nil
+	}
+
+	connector := getPolicyConnector(m)
+	client := cluster.NewApiCertificateClient(connector)
+	certID := getPolicyIDFromPath(d.Get("certificate_path").(string))
+	_, err := client.Clearclustercertificate(certID)
+	if err != nil {
+		return handleDeleteError("CertificateServiceBinding", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtCertificateServiceBindingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.SplitN(importID, "/", 2)
+	serviceType := s[0]
+	switch serviceType {
+	case trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_MGMT_CLUSTER:
+		if len(s) != 1 {
+			return nil, fmt.Errorf("Please provide %s as import ID", serviceType)
+		}
+	case trust_management.Certificates_APPLYCERTIFICATE_SERVICE_TYPE_API:
+		if len(s) != 2 || s[1] == "" {
+			return nil, fmt.Errorf("Please provide %s/<node-id> as import ID", serviceType)
+		}
+		d.Set("node_id", s[1])
+	default:
+		return nil, fmt.Errorf("Unsupported service type in import ID %s, expected one of %v", importID, certificateServiceTypeValues)
+	}
+	d.Set("service_type", serviceType)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,94 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccResourceNsxtCertificateServiceBinding_basic(t *testing.T) {
+	testResourceName := "nsxt_certificate_service_binding.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			// Replacing cluster certificate affects API clients, hence this test is opt-in
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_SERVICE_BINDING")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtCertificateServiceBindingTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "service_type", "MGMT_CLUSTER"),
+					resource.TestCheckResourceAttrPair(testResourceName, "certificate_path", "nsxt_policy_self_signed_certificate.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "subject_cn", "test.example.com"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceNsxtCertificateServiceBindingImport(t *testing.T) {
+	tests := []struct {
+		importID    string
+		serviceType string
+		nodeID      string
+		expectError bool
+	}{
+		{importID: "MGMT_CLUSTER", serviceType: "MGMT_CLUSTER"},
+		{importID: "API/node-1", serviceType: "API", nodeID: "node-1"},
+		{importID: "MGMT_CLUSTER/node-1", expectError: true},
+		{importID: "API", expectError: true},
+		{importID: "API/", expectError: true},
+		{importID: "HTTP/node-1", expectError: true},
+	}
+
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourceNsxtCertificateServiceBinding().Schema, map[string]interface{}{})
+		d.SetId(test.importID)
+		_, err := resourceNsxtCertificateServiceBindingImport(d, nil)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("expected error for import ID %s", test.importID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for import ID %s: %v", test.importID, err)
+			continue
+		}
+		if d.Get("service_type").(string) != test.serviceType || d.Get("node_id").(string) != test.nodeID {
+			t.Errorf("unexpected service_type %s and node_id %s for import ID %s", d.Get("service_type"), d.Get("node_id"), test.importID)
+		}
+	}
+}
+
+func testAccNsxtCertificateServiceBindingTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_self_signed_certificate" "test" {
+  display_name = "%s"
+
+  subject {
+    common_name = "test.example.com"
+  }
+}
+
+resource "nsxt_certificate_service_binding" "test" {
+  certificate_path = nsxt_policy_self_signed_certificate.test.path
+  service_type     = "MGMT_CLUSTER"
+}`, name)
+}
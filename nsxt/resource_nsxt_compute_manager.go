@@ -74,10 +74,11 @@ func resourceNsxtComputeManager() *schema.Resource {
 										Required:    true,
 									},
 									"token": {
-										Type:        schema.TypeString,
-										Description: "The saml token to login to server",
-										Required:    true,
-										Sensitive:   true,
+										Type:             schema.TypeString,
+										Description:      "The saml token to login to server",
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: secretDiffSuppressFunc,
 									},
 								},
 							},
@@ -90,10 +91,11 @@ func resourceNsxtComputeManager() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"session_id": {
-										Type:        schema.TypeString,
-										Description: "The session_id to login to server",
-										Required:    true,
-										Sensitive:   true,
+										Type:             schema.TypeString,
+										Description:      "The session_id to login to server",
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: secretDiffSuppressFunc,
 									},
 									"thumbprint": {
 										Type:        schema.TypeString,
@@ -111,10 +113,11 @@ func resourceNsxtComputeManager() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"password": {
-										Type:        schema.TypeString,
-										Description: "The authentication password for login",
-										Required:    true,
-										Sensitive:   true,
+										Type:             schema.TypeString,
+										Description:      "The authentication password for login",
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: secretDiffSuppressFunc,
 									},
 									"thumbprint": {
 										Type:        schema.TypeString,
@@ -137,22 +140,25 @@ func resourceNsxtComputeManager() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"asymmetric_credential": {
-										Type:        schema.TypeString,
-										Description: "Asymmetric login credential",
-										Required:    true,
-										Sensitive:   true,
+										Type:             schema.TypeString,
+										Description:      "Asymmetric login credential",
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: secretDiffSuppressFunc,
 									},
 									"credential_key": {
-										Type:        schema.TypeString,
-										Description: "Credential key",
-										Required:    true,
-										Sensitive:   true,
+										Type:             schema.TypeString,
+										Description:      "Credential key",
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: secretDiffSuppressFunc,
 									},
 									"credential_verifier": {
-										Type:        schema.TypeString,
-										Description: "Credential verifier",
-										Required:    true,
-										Sensitive:   true,
+										Type:             schema.TypeString,
+										Description:      "Credential verifier",
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: secretDiffSuppressFunc,
 									},
 								},
 							},
@@ -160,6 +166,7 @@ func resourceNsxtComputeManager() *schema.Resource {
 					},
 				},
 			},
+			"credential_version": getSecretVersionSchema("credential"),
 			"extension_certificate": {
 				Type:        schema.TypeList,
 				Description: "Specifies certificate for compute manager extension",
@@ -294,7 +301,7 @@ func getCredentialValues(d *schema.ResourceData) (*data.StructValue, error) {
 		switch credType {
 		case "saml_login":
 			thumbPrint := cData["thumbprint"].(string)
-			token := cData["token"].(string)
+			token := getSecretFromConfig(d, "credential", 0, credType, 0, "token")
 			cred := model.SamlTokenLoginCredential{
 				Thumbprint:     &thumbPrint,
 				Token:          &token,
@@ -303,7 +310,7 @@ func getCredentialValues(d *schema.ResourceData) (*data.StructValue, error) {
 			dataValue, errs = converter.ConvertToVapi(cred, model.SamlTokenLoginCredentialBindingType())
 
 		case "session_login":
-			sessionID := cData["session_id"].(string)
+			sessionID := getSecretFromConfig(d, "credential", 0, credType, 0, "session_id")
 			thumbPrint := cData["thumbprint"].(string)
 			cred := model.SessionLoginCredential{
 				SessionId:      &sessionID,
@@ -313,7 +320,7 @@ func getCredentialValues(d *schema.ResourceData) (*data.StructValue, error) {
 			dataValue, errs = converter.ConvertToVapi(cred, model.SessionLoginCredentialBindingType())
 
 		case "username_password_login":
-			password := cData["password"].(string)
+			password := getSecretFromConfig(d, "credential", 0, credType, 0, "password")
 			thumbPrint := cData["thumbprint"].(string)
 			username := cData["username"].(string)
 
@@ -326,9 +333,9 @@ func getCredentialValues(d *schema.ResourceData) (*data.StructValue, error) {
 			dataValue, errs = converter.ConvertToVapi(cred, model.UsernamePasswordLoginCredentialBindingType())
 
 		case "verifiable_asymmetric_login":
-			asymmetricCredential := cData["asymmetric_credential"].(string)
-			credentialKey := cData["credential_key"].(string)
-			credentialVerifier := cData["credential_verifier"].(string)
+			asymmetricCredential := getSecretFromConfig(d, "credential", 0, credType, 0, "asymmetric_credential")
+			credentialKey := getSecretFromConfig(d, "credential", 0, credType, 0, "credential_key")
+			credentialVerifier := getSecretFromConfig(d, "credential", 0, credType, 0, "credential_verifier")
 
 			cred := model.VerifiableAsymmetricLoginCredential{
 				AsymmetricCredential: &asymmetricCredential,
@@ -396,6 +403,12 @@ func resourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
+// Secrets are not set from NSX response, and only their hash is kept in state
+func setCredentialSecretHashInElem(elem map[string]interface{}, key string) {
+	value, _ := elem[key].(string)
+	elem[key] = getSecretHash(value)
+}
+
 func setCredentialValuesInSchema(d *schema.ResourceData, credential *data.StructValue) error {
 	converter := bindings.NewTypeConverter()
 	parentElem := getElemOrEmptyMapFromSchema(d, "credential")
@@ -415,7 +428,7 @@ func setCredentialValuesInSchema(d *schema.ResourceData, credential *data.Struct
 		}
 		credEntry := entry.(model.SamlTokenLoginCredential)
 		elem["thumbprint"] = credEntry.Thumbprint
-		elem["token"] = credEntry.Token
+		setCredentialSecretHashInElem(elem, "token")
 		parentElem["saml_login"] = []interface{}{elem}
 
 	case model.SessionLoginCredential__TYPE_IDENTIFIER:
@@ -425,7 +438,7 @@ func setCredentialValuesInSchema(d *schema.ResourceData, credential *data.Struct
 			return errs[0]
 		}
 		credEntry := entry.(model.SessionLoginCredential)
-		elem["session_id"] = credEntry.SessionId
+		setCredentialSecretHashInElem(elem, "session_id")
 		elem["thumbprint"] = credEntry.Thumbprint
 		parentElem["session_login"] = []interface{}{elem}
 
@@ -440,23 +453,16 @@ func setCredentialValuesInSchema(d *schema.ResourceData, credential *data.Struct
 		if credEntry.Username != nil {
 			elem["username"] = credEntry.Username
 		}
-		// Normally NSX won't return credEntry.Password
-		if credEntry.Password != nil {
-			elem["password"] = credEntry.Password
-		}
+		// Password is never set from NSX response, even if returned
+		setCredentialSecretHashInElem(elem, "password")
 		elem["thumbprint"] = credEntry.Thumbprint
 		parentElem["username_password_login"] = []interface{}{elem}
 
 	case model.VerifiableAsymmetricLoginCredential__TYPE_IDENTIFIER:
 		elem := getElemOrEmptyMapFromMap(parentElem, "verifiable_asymmetric_login")
-		entry, errs := converter.ConvertToGolang(credential, model.VerifiableAsymmetricLoginCredentialBindingType())
-		if errs != nil {
-			return errs[0]
-		}
-		credEntry := entry.(model.VerifiableAsymmetricLoginCredential)
-		elem["asymmetric_credential"] = credEntry.AsymmetricCredential
-		elem["credential_key"] = credEntry.CredentialKey
-		elem["credential_verifier"] = credEntry.CredentialVerifier
+		setCredentialSecretHashInElem(elem, "asymmetric_credential")
+		setCredentialSecretHashInElem(elem, "credential_key")
+		setCredentialSecretHashInElem(elem, "credential_verifier")
 		parentElem["verifiable_asymmetric_login"] = []interface{}{elem}
 
 	default:
@@ -90,8 +90,9 @@ func resourceNsxtEdgeTransportNode() *schema.Resource {
 			"standard_host_switch": getStandardHostSwitchSchema(nodeTypeEdge),
 
 			// node_deployment_info
-			"deployment_config": getEdgeNodeDeploymentConfigSchema(),
-			"node_settings":     getEdgeNodeSettingsSchema(),
+			"deployment_config":     getEdgeNodeDeploymentConfigSchema(),
+			"node_settings":         getEdgeNodeSettingsSchema(),
+			"node_password_version": getSecretVersionSchema("node_user_settings passwords"),
 			"external_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -167,10 +168,11 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"audit_password": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								Sensitive:   true,
-								Description: "Node audit user password",
+								Type:             schema.TypeString,
+								Optional:         true,
+								Sensitive:        true,
+								DiffSuppressFunc: secretDiffSuppressFunc,
+								Description:      "Node audit user password",
 							},
 							"audit_username": {
 								Type:        schema.TypeString,
@@ -178,10 +180,11 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 								Description: "CLI \"audit\" username",
 							},
 							"cli_password": {
-								Type:        schema.TypeString,
-								Required:    true,
-								Sensitive:   true,
-								Description: "Node cli password",
+								Type:             schema.TypeString,
+								Required:         true,
+								Sensitive:        true,
+								DiffSuppressFunc: secretDiffSuppressFunc,
+								Description:      "Node cli password",
 							},
 							"cli_username": {
 								Type:        schema.TypeString,
@@ -190,10 +193,11 @@ func getEdgeNodeDeploymentConfigSchema() *schema.Schema {
 								Description: "CLI \"admin\" username",
 							},
 							"root_password": {
-								Type:        schema.TypeString,
-								Required:    true,
-								Sensitive:   true,
-								Description: "Node root user password",
+								Type:             schema.TypeString,
+								Required:         true,
+								Sensitive:        true,
+								DiffSuppressFunc: secretDiffSuppressFunc,
+								Description:      "Node root user password",
 							},
 						},
 					},
@@ -710,7 +714,7 @@ func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, e
 	id := d.Get("id").(string)
 	ipAddresses := interfaceListToStringList(d.Get("ip_addresses").([]interface{}))
 
-	deploymentConfig, err := getEdgeNodeDeploymentConfigFromSchema(d.Get("deployment_config"))
+	deploymentConfig, err := getEdgeNodeDeploymentConfigFromSchema(d)
 	if err != nil {
 		return nil, err
 	}
@@ -793,24 +797,25 @@ func getRemoteTunnelEndpointFromSchema(d *schema.ResourceData) (*model.Transport
 	return nil, nil
 }
 
-func getEdgeNodeDeploymentConfigFromSchema(cfg interface{}) (*model.EdgeNodeDeploymentConfig, error) {
+func getEdgeNodeDeploymentConfigFromSchema(d *schema.ResourceData) (*model.EdgeNodeDeploymentConfig, error) {
 	converter := bindings.NewTypeConverter()
 
+	cfg := d.Get("deployment_config")
 	if cfg == nil {
 		return nil, nil
 	}
-	for _, ci := range cfg.([]interface{}) {
+	for i, ci := range cfg.([]interface{}) {
 		c := ci.(map[string]interface{})
 		formFactor := c["form_factor"].(string)
 		var nodeUserSettings *model.NodeUserSettings
 		if c["node_user_settings"] != nil {
-			for _, nusi := range c["node_user_settings"].([]interface{}) {
+			for j, nusi := range c["node_user_settings"].([]interface{}) {
 				nus := nusi.(map[string]interface{})
-				auditPassword := nus["audit_password"].(string)
+				auditPassword := getSecretFromConfig(d, "deployment_config", i, "node_user_settings", j, "audit_password")
 				auditUsername := nus["audit_username"].(string)
-				cliPassword := nus["cli_password"].(string)
+				cliPassword := getSecretFromConfig(d, "deployment_config", i, "node_user_settings", j, "cli_password")
 				cliUsername := nus["cli_username"].(string)
-				rootPassword := nus["root_password"].(string)
+				rootPassword := getSecretFromConfig(d, "deployment_config", i, "node_user_settings", j, "root_password")
 
 				nodeUserSettings = &model.NodeUserSettings{
 					CliPassword:  &cliPassword,
@@ -1355,7 +1360,11 @@ func setEdgeDeploymentConfigInSchema(d *schema.ResourceData, deploymentConfig *m
 	} else {
 		nodeUserSettings = make(map[string]interface{})
 	}
-	// Note: password attributes is sensitive and is not returned by NSX
+	// Note: password attributes is sensitive and is not returned by NSX, only hash is kept in state
+	for _, key := range []string{"audit_password", "cli_password", "root_password"} {
+		value, _ := nodeUserSettings[key].(string)
+		nodeUserSettings[key] = getSecretHash(value)
+	}
 	if deploymentConfig.NodeUserSettings.AuditUsername != nil {
 		nodeUserSettings["audit_username"] = deploymentConfig.NodeUserSettings.AuditUsername
 	}
@@ -0,0 +1,243 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/licenses"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+func validateLicenseKey() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(
+			"^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$"),
+		"Must be a valid nsx license key matching: ^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$")
+}
+
+func resourceNsxtLicense() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtLicenseCreate,
+		Read:   resourceNsxtLicenseRead,
+		Delete: resourceNsxtLicenseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtLicenseImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"license_key": {
+				Type:         schema.TypeString,
+				Description:  "License key",
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validateLicenseKey(),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "License edition",
+				Computed:    true,
+			},
+			"product_name": {
+				Type:        schema.TypeString,
+				Description: "Product name",
+				Computed:    true,
+			},
+			"product_version": {
+				Type:        schema.TypeString,
+				Description: "Product version",
+				Computed:    true,
+			},
+			"features": {
+				Type:        schema.TypeString,
+				Description: "Features included in this license",
+				Computed:    true,
+			},
+			"capacity_type": {
+				Type:        schema.TypeString,
+				Description: "License metric",
+				Computed:    true,
+			},
+			"quantity": {
+				Type:        schema.TypeInt,
+				Description: "Licensed capacity, 0 for unlimited",
+				Computed:    true,
+			},
+			"expiry": {
+				Type:        schema.TypeString,
+				Description: "License expiry, in RFC3339 format. Empty for perpetual license",
+				Computed:    true,
+			},
+			"is_eval": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is evaluation license",
+				Computed:    true,
+			},
+			"is_expired": {
+				Type:        schema.TypeBool,
+				Description: "Whether the license has expired",
+				Computed:    true,
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Description: "Capacity usage of features included in this license, in terms of license capacity type",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature": {
+							Type:        schema.TypeString,
+							Description: "Feature name",
+							Computed:    true,
+						},
+						"usage_count": {
+							Type:        schema.TypeInt,
+							Description: "Consumed capacity",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// License key is a secret, hence resource ID is derived from the key rather than
+// being the key itself
+func getLicenseID(licenseKey string) string {
+	sum := sha256.Sum256([]byte(licenseKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func resourceNsxtLicenseCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := nsx.NewLicensesClient(connector)
+
+	licenseKey := d.Get("license_key").(string)
+	obj := nsxModel.License{
+		LicenseKey: &licenseKey,
+	}
+
+	id := getLicenseID(licenseKey)
+	log.Printf("[INFO] Applying License %s", id)
+	_, err := client.Create(obj)
+	if err != nil {
+		return handleCreateError("License", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtLicenseRead(d, m)
+}
+
+// NSX reports usage per feature across all licenses, hence usage is narrowed down to
+// features included in the license (features are semicolon delimited)
+func getLicenseUsageFromFeatureUsage(featureUsage []nsxModel.FeatureUsage, features *string, capacityType *string) []interface{} {
+	var usageList []interface{}
+	if capacityType == nil || features == nil {
+		return usageList
+	}
+
+	licenseFeatures := make(map[string]bool)
+	for _, feature := range strings.Split(*features, ";") {
+		licenseFeatures[strings.TrimSpace(feature)] = true
+	}
+
+	for _, feature := range featureUsage {
+		if feature.Feature == nil || !licenseFeatures[*feature.Feature] {
+			continue
+		}
+		for _, capacity := range feature.CapacityUsage {
+			if capacity.CapacityType == nil || *capacity.CapacityType != *capacityType {
+				continue
+			}
+			elem := make(map[string]interface{})
+			elem["feature"] = feature.Feature
+			elem["usage_count"] = capacity.UsageCount
+			usageList = append(usageList, elem)
+		}
+	}
+
+	return usageList
+}
+
+func resourceNsxtLicenseRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := nsx.NewLicensesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining License ID")
+	}
+
+	licenseKey := d.Get("license_key").(string)
+	obj, err := client.Getlicensebykey(licenseKey)
+	if err != nil {
+		return handleReadError(d, "License", id, err)
+	}
+
+	d.Set("license_key", obj.LicenseKey)
+	d.Set("description", obj.Description)
+	d.Set("product_name", obj.ProductName)
+	d.Set("product_version", obj.ProductVersion)
+	d.Set("features", obj.Features)
+	d.Set("capacity_type", obj.CapacityType)
+	d.Set("quantity", obj.Quantity)
+	d.Set("is_eval", obj.IsEval)
+	d.Set("is_expired", obj.IsExpired)
+	expiry := ""
+	if obj.Expiry != nil && *obj.Expiry > 0 {
+		expiry = time.UnixMilli(*obj.Expiry).UTC().Format(time.RFC3339)
+	}
+	d.Set("expiry", expiry)
+
+	usageClient := licenses.NewLicensesUsageClient(connector)
+	usage, err := usageClient.Get()
+	if err != nil {
+		return handleReadError(d, "LicenseUsage", id, err)
+	}
+
+	return d.Set("usage", getLicenseUsageFromFeatureUsage(usage.FeatureUsageInfo, obj.Features, obj.CapacityType))
+}
+
+func resourceNsxtLicenseDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := nsx.NewLicensesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining License ID")
+	}
+
+	licenseKey := d.Get("license_key").(string)
+	obj := nsxModel.License{
+		LicenseKey: &licenseKey,
+	}
+
+	log.Printf("[INFO] Removing License %s", id)
+	err := client.Delete0(obj)
+	if err != nil {
+		return handleDeleteError("License", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtLicenseImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	licenseKey := d.Id()
+	if err := d.Set("license_key", licenseKey); err != nil {
+		return nil, err
+	}
+	d.SetId(getLicenseID(licenseKey))
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,181 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+func TestAccResourceNsxtLicense_basic(t *testing.T) {
+	testResourceName := "nsxt_license.test"
+	licenseKey := getTestLicenseKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_LICENSE_KEY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtLicenseCheckDestroy(state, licenseKey)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtLicenseTemplate(licenseKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtLicenseExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "license_key", licenseKey),
+					resource.TestCheckResourceAttr(testResourceName, "is_expired", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "description"),
+					resource.TestCheckResourceAttrSet(testResourceName, "capacity_type"),
+					resource.TestCheckResourceAttrSet(testResourceName, "quantity"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtLicense_importBasic(t *testing.T) {
+	testResourceName := "nsxt_license.test"
+	licenseKey := getTestLicenseKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_LICENSE_KEY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtLicenseCheckDestroy(state, licenseKey)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtLicenseTemplate(licenseKey),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     licenseKey,
+			},
+		},
+	})
+}
+
+func testAccNsxtLicenseExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		client := nsx.NewLicensesClient(connector)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("License resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("License resource ID not set in resources")
+		}
+
+		_, err := client.Getlicensebykey(rs.Primary.Attributes["license_key"])
+		if err != nil {
+			return fmt.Errorf("Error while retrieving License %s: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtLicenseCheckDestroy(state *terraform.State, licenseKey string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := nsx.NewLicensesClient(connector)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_license" {
+			continue
+		}
+
+		_, err := client.Getlicensebykey(rs.Primary.Attributes["license_key"])
+		if err == nil {
+			return fmt.Errorf("License %s still exists", licenseKey)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtLicenseTemplate(licenseKey string) string {
+	return fmt.Sprintf(`
+resource "nsxt_license" "test" {
+  license_key = "%s"
+}`, licenseKey)
+}
+
+func TestGetLicenseUsageFromFeatureUsage(t *testing.T) {
+	cpu := "CPU"
+	vm := "VM"
+	firewall := "Distributed Firewall"
+	switching := "Switching and Routing"
+	vpn := "VPN"
+	count := int64(4)
+	vmCount := int64(20)
+	featureUsage := []nsxModel.FeatureUsage{
+		{
+			Feature: &firewall,
+			CapacityUsage: []nsxModel.CapacityUsage{
+				{CapacityType: &cpu, UsageCount: &count},
+				{CapacityType: &vm, UsageCount: &vmCount},
+			},
+		},
+		{
+			Feature:       &switching,
+			CapacityUsage: []nsxModel.CapacityUsage{{CapacityType: &cpu, UsageCount: &count}},
+		},
+		{
+			Feature:       &vpn,
+			CapacityUsage: []nsxModel.CapacityUsage{{CapacityType: &cpu, UsageCount: &count}},
+		},
+	}
+
+	features := "Distributed Firewall; Switching and Routing"
+	usage := getLicenseUsageFromFeatureUsage(featureUsage, &features, &cpu)
+	if len(usage) != 2 {
+		t.Fatalf("expected usage of 2 features, got %v", usage)
+	}
+	for i, feature := range []string{firewall, switching} {
+		elem := usage[i].(map[string]interface{})
+		if *elem["feature"].(*string) != feature || *elem["usage_count"].(*int64) != count {
+			t.Errorf("unexpected usage for feature %s: %v", feature, elem)
+		}
+	}
+
+	if usage := getLicenseUsageFromFeatureUsage(featureUsage, nil, &cpu); len(usage) != 0 {
+		t.Errorf("expected no usage without license features, got %v", usage)
+	}
+	if usage := getLicenseUsageFromFeatureUsage(featureUsage, &features, nil); len(usage) != 0 {
+		t.Errorf("expected no usage without capacity type, got %v", usage)
+	}
+}
+
+func TestGetLicenseID(t *testing.T) {
+	licenseKey := "AAAAA-BBBBB-CCCCC-DDDDD-EEEEE"
+	id := getLicenseID(licenseKey)
+	if strings.Contains(id, licenseKey) {
+		t.Errorf("expected license ID not to contain the license key")
+	}
+	if id != getLicenseID(licenseKey) {
+		t.Errorf("expected license ID to be stable")
+	}
+	if id == getLicenseID("AAAAA-BBBBB-CCCCC-DDDDD-FFFFF") {
+		t.Errorf("expected different license IDs for different keys")
+	}
+}
@@ -37,12 +37,21 @@ func resourceNsxtUsers() *schema.Resource {
 				Computed:    true,
 			},
 			"password": {
-				Type:         schema.TypeString,
-				Description:  "Password for the user",
-				Sensitive:    true,
-				Optional:     true,
-				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Type:             schema.TypeString,
+				Description:      "Password for the user",
+				Sensitive:        true,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: secretDiffSuppressFunc,
+			},
+			"old_password": {
+				Type:             schema.TypeString,
+				Description:      "Current password of the user, required by NSX when password of an active user is changed",
+				Sensitive:        true,
+				Optional:         true,
+				DiffSuppressFunc: secretDiffSuppressFunc,
 			},
+			"password_version": getSecretVersionSchema("password"),
 			"password_change_frequency": {
 				Type:         schema.TypeInt,
 				Description:  "Number of days password is valid before it must be changed",
@@ -118,7 +127,7 @@ func resourceNsxtNodeUserCreate(d *schema.ResourceData, m interface{}) error {
 	passwordChangeFrequency := int64(d.Get("password_change_frequency").(int))
 	passwordChangeWarning := int64(d.Get("password_change_warning").(int))
 	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	password := getSecretFromConfig(d, "password")
 
 	userProp := nsxModel.NodeUserProperties{
 		FullName:                &fullName,
@@ -152,7 +161,9 @@ func resourceNsxtNodeUserRead(d *schema.ResourceData, m interface{}) error {
 		return handleReadError(d, "User", id, err)
 	}
 
-	// Password not return on GET
+	// Password not return on GET, only its hash is kept in state
+	setSecretHashInSchema(d, "password")
+	setSecretHashInSchema(d, "old_password")
 	d.Set("full_name", user.FullName)
 	d.Set("last_password_change", user.LastPasswordChange)
 	d.Set("password_change_frequency", user.PasswordChangeFrequency)
@@ -176,12 +187,19 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	if id == "" {
 		return fmt.Errorf("error obtaining logical object id")
 	}
-	oldPwd, pwd := d.GetChange("password")
-	password := pwd.(string)
-	oldPassword := oldPwd.(string)
+	// Only hash of the password is stored in state, hence cleartext values are
+	// taken from configuration
+	password := getSecretFromConfig(d, "password")
+	oldPassword := getSecretFromConfig(d, "old_password")
 
 	active := d.Get("active").(bool)
 	status := d.Get("status").(string)
+	passwordChanged := len(password) > 0 && (d.HasChange("password") || d.HasChange("password_version"))
+
+	// NSX requires current password in order to change password of an activated user
+	if passwordChanged && status != nsxModel.NodeUserProperties_STATUS_NOT_ACTIVATED && len(oldPassword) == 0 {
+		return fmt.Errorf("must specify old_password to change password of Nsxt Node user %s", id)
+	}
 
 	// Handle user status change first
 	// Password reset can be achieved by deactivating then re-activate the account
@@ -213,9 +231,11 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// If password is changed, handle password change.
-	if password != oldPassword {
+	if passwordChanged {
 		userProp.Password = &password
-		userProp.OldPassword = &oldPassword
+		if len(oldPassword) > 0 {
+			userProp.OldPassword = &oldPassword
+		}
 	}
 
 	_, err := client.Update(id, userProp)
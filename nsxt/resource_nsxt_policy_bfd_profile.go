@@ -0,0 +1,165 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyBfdProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyBfdProfileCreate,
+		Read:   resourceNsxtPolicyBfdProfileRead,
+		Update: resourceNsxtPolicyBfdProfileUpdate,
+		Delete: resourceNsxtPolicyBfdProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"interval": {
+				Type:         schema.TypeInt,
+				Description:  "Time interval between heartbeat packets in milliseconds",
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validation.IntBetween(50, 60000),
+			},
+			"multiple": {
+				Type:         schema.TypeInt,
+				Description:  "Number of times heartbeat packet is missed before BFD declares the neighbor is down",
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(2, 16),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyBfdProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewBfdProfilesClient(sessionContext, connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyBfdProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	interval := int64(d.Get("interval").(int))
+	multiple := int64(d.Get("multiple").(int))
+
+	obj := model.BfdProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Interval:    &interval,
+		Multiple:    &multiple,
+	}
+
+	log.Printf("[INFO] Patching BfdProfile with ID %s", id)
+	client := infra.NewBfdProfilesClient(getSessionContext(d, m), connector)
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyBfdProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyBfdProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyBfdProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("BfdProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyBfdProfileRead(d, m)
+}
+
+func resourceNsxtPolicyBfdProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BfdProfile ID")
+	}
+
+	client := infra.NewBfdProfilesClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "BfdProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("interval", obj.Interval)
+	d.Set("multiple", obj.Multiple)
+
+	return nil
+}
+
+func resourceNsxtPolicyBfdProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BfdProfile ID")
+	}
+
+	err := resourceNsxtPolicyBfdProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("BfdProfile", id, err)
+	}
+
+	return resourceNsxtPolicyBfdProfileRead(d, m)
+}
+
+func resourceNsxtPolicyBfdProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BfdProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewBfdProfilesClient(getSessionContext(d, m), connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("BfdProfile", id, err)
+	}
+
+	return nil
+}
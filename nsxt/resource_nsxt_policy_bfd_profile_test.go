@@ -0,0 +1,182 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyBfdProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"interval":     "1000",
+	"multiple":     "4",
+}
+
+var accTestPolicyBfdProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"interval":     "300",
+	"multiple":     "2",
+}
+
+func TestAccResourceNsxtPolicyBfdProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_bfd_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyBfdProfileCheckDestroy(state, accTestPolicyBfdProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBfdProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyBfdProfileExists(accTestPolicyBfdProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyBfdProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyBfdProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyBfdProfileCreateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "multiple", accTestPolicyBfdProfileCreateAttributes["multiple"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyBfdProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyBfdProfileExists(accTestPolicyBfdProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyBfdProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyBfdProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyBfdProfileUpdateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "multiple", accTestPolicyBfdProfileUpdateAttributes["multiple"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyBfdProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyBfdProfileExists(accTestPolicyBfdProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "interval", "500"),
+					resource.TestCheckResourceAttr(testResourceName, "multiple", "3"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyBfdProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_bfd_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyBfdProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBfdProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBfdProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy BfdProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy BfdProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyBfdProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy BfdProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyBfdProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_bfd_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyBfdProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy BfdProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyBfdProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyBfdProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyBfdProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_bfd_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  interval     = %s
+  multiple     = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["interval"], attrMap["multiple"])
+}
+
+func testAccNsxtPolicyBfdProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_bfd_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyBfdProfileUpdateAttributes["display_name"])
+}
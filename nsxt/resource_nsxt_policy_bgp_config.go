@@ -5,8 +5,10 @@ package nsxt
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gm_tier_0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
 	gm_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s/locale_services"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
@@ -25,6 +27,9 @@ func resourceNsxtPolicyBgpConfig() *schema.Resource {
 		Read:   resourceNsxtPolicyBgpConfigRead,
 		Update: resourceNsxtPolicyBgpConfigUpdate,
 		Delete: resourceNsxtPolicyBgpConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyBgpConfigImport,
+		},
 
 		Schema: bgpSchema,
 	}
@@ -216,6 +221,10 @@ func resourceNsxtPolicyBgpConfigUpdate(d *schema.ResourceData, m interface{}) er
 		return handleUpdateError("BgpRoutingConfig", gwID, err)
 	}
 
+	bgpPath := getPolicyLocaleServiceLockPath(gwPath, serviceID) + "/bgp"
+	lockPolicyParent(bgpPath)
+	defer unlockPolicyParent(bgpPath)
+
 	obj.Revision = &revision
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.BgpRoutingConfigBindingType(), gm_model.BgpRoutingConfigBindingType())
@@ -248,3 +257,39 @@ func resourceNsxtPolicyBgpConfigDelete(d *schema.ResourceData, m interface{}) er
 
 	return nil
 }
+
+func resourceNsxtPolicyBgpConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	err := fmt.Errorf("Expected BGP config path, got %s", importID)
+	// Path should be like /infra/tier-0s/aaa/locale-services/default/bgp
+	if !isPolicyPath(importID) || !strings.HasSuffix(importID, "/bgp") {
+		return nil, err
+	}
+	isT0, gwID, localeServiceID, parseErr := parseLocaleServicePolicyPath(strings.TrimSuffix(importID, "/bgp"))
+	if parseErr != nil || !isT0 {
+		return nil, err
+	}
+	gwPath, _ := getAncestorPathFromResourcePath(importID, "tier-0s")
+
+	if isPolicyGlobalManager(m) {
+		// Site is derived from edge cluster of the locale service
+		client := gm_tier_0s.NewLocaleServicesClient(getPolicyConnector(m))
+		localeService, err := client.Get(gwID, localeServiceID)
+		if err != nil {
+			return nil, err
+		}
+		if localeService.EdgeClusterPath != nil {
+			sitePath, err := getAncestorPathFromResourcePath(*localeService.EdgeClusterPath, "sites")
+			if err == nil {
+				d.Set("site_path", sitePath)
+			}
+		}
+	}
+
+	d.Set("gateway_path", gwPath)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+	d.SetId(newUUID())
+
+	return []*schema.ResourceData{d}, nil
+}
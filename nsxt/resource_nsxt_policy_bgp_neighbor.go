@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -117,12 +118,14 @@ func resourceNsxtPolicyBgpNeighbor() *schema.Resource {
 				ValidateFunc: validateSingleIP(),
 			},
 			"password": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Password for BGP neighbor authentication",
-				ValidateFunc: validation.StringLenBetween(0, 20),
-				Sensitive:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Password for BGP neighbor authentication",
+				ValidateFunc:     validation.StringLenBetween(0, 20),
+				Sensitive:        true,
+				DiffSuppressFunc: secretDiffSuppressFunc,
 			},
+			"password_version": getSecretVersionSchema("password"),
 			"remote_as_num": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -223,7 +226,7 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 	keepAliveTime := int64(d.Get("keep_alive_time").(int))
 	maximumHopLimit := int64(d.Get("maximum_hop_limit").(int))
 	neighborAddress := d.Get("neighbor_address").(string)
-	password := d.Get("password").(string)
+	password := getSecretFromConfig(d, "password")
 	remoteAsNum := d.Get("remote_as_num").(string)
 	sourceAddresses := interface2StringList(d.Get("source_addresses").([]interface{}))
 
@@ -296,7 +299,7 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 		Id:                  &id,
 	}
 
-	if d.HasChange("password") {
+	if d.HasChange("password") || d.HasChange("password_version") {
 		neighborStruct.Password = &password
 	}
 
@@ -315,6 +318,10 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 		return err
 	}
 
+	// Neighbors are part of BGP config, which may be modified concurrently
+	lockPolicyParent(bgpPath)
+	defer unlockPolicyParent(bgpPath)
+
 	connector := getPolicyConnector(m)
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating BgpNeighbor with ID %s", id)
@@ -412,7 +419,8 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
-	// NOTE: password is not returned on API responses
+	// NOTE: password is not returned on API responses, only its hash is kept in state
+	setSecretHashInSchema(d, "password")
 	d.Set("allow_as_in", obj.AllowAsIn)
 	d.Set("graceful_restart_mode", obj.GracefulRestartMode)
 	d.Set("hold_down_time", int(*obj.HoldDownTime))
@@ -487,6 +495,9 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 
+	lockPolicyParent(bgpPath)
+	defer unlockPolicyParent(bgpPath)
+
 	var err error
 	if isPolicyGlobalManager(m) {
 		client := gm_bgp.NewNeighborsClient(connector)
@@ -504,6 +515,18 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 
 func resourceNsxtPolicyBgpNeighborImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err == nil {
+		bgpPath, err := getParameterFromPolicyPath("", "/neighbors/", importID)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("bgp_path", bgpPath)
+		return rd, nil
+	} else if !errors.Is(err, ErrNotAPolicyPath) {
+		return rd, err
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
 		return nil, fmt.Errorf("Please provide <tier0-id>/<locale-service-id>/<neighbor-id> as an input")
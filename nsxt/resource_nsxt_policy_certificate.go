@@ -0,0 +1,232 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyCertificatePurposeValues = []string{model.TlsTrustData_PURPOSE_CA}
+
+// Certificates can not be modified on NSX, hence all arguments force re-creation
+func resourceNsxtPolicyCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCertificateCreate,
+		Read:   resourceNsxtPolicyCertificateRead,
+		Delete: resourceNsxtPolicyCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: addCertificateDetailsSchema(map[string]*schema.Schema{
+			"nsx_id": {
+				Type:          schema.TypeString,
+				Description:   "NSX ID for this resource",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"csr_path"},
+			},
+			"path": getPathSchema(),
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name for this resource",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description for this resource",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"revision": getRevisionSchema(),
+			"tag":      getTagsSchemaForceNew(),
+			"pem_encoded": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate or certificate chain",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded private key",
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: secretDiffSuppressFunc,
+				ConflictsWith:    []string{"csr_path"},
+			},
+			"passphrase": {
+				Type:             schema.TypeString,
+				Description:      "Passphrase of the private key",
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: secretDiffSuppressFunc,
+				ConflictsWith:    []string{"csr_path"},
+			},
+			"key_algo": {
+				Type:        schema.TypeString,
+				Description: "Key algorithm contained in this certificate",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"purpose": {
+				Type:         schema.TypeString,
+				Description:  "Purpose of this certificate",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(policyCertificatePurposeValues, false),
+			},
+			"csr_path": getPolicyPathSchemaExtended(false, true, "Policy path of certificate signing request this CA-signed certificate is issued for", "", []string{"private_key", "passphrase"}),
+			"certificate_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the certificate",
+				Computed:    true,
+			},
+			"has_private_key": {
+				Type:        schema.TypeBool,
+				Description: "Whether private key is stored with this certificate",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func getPolicyTlsTrustDataFromSchema(d *schema.ResourceData) model.TlsTrustData {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+	privateKey := getSecretFromConfig(d, "private_key")
+	passphrase := getSecretFromConfig(d, "passphrase")
+	keyAlgo := d.Get("key_algo").(string)
+	purpose := d.Get("purpose").(string)
+
+	obj := model.TlsTrustData{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+	if privateKey != "" {
+		obj.PrivateKey = &privateKey
+	}
+	if passphrase != "" {
+		obj.Passphrase = &passphrase
+	}
+	if keyAlgo != "" {
+		obj.KeyAlgo = &keyAlgo
+	}
+	if purpose != "" {
+		obj.Purpose = &purpose
+	}
+
+	return obj
+}
+
+func resourceNsxtPolicyCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	obj := getPolicyTlsTrustDataFromSchema(d)
+
+	csrPath := d.Get("csr_path").(string)
+	if csrPath != "" {
+		// Certificate signed by CA for CSR generated on NSX. ID is assigned by NSX,
+		// and the CSR is removed by NSX once the certificate is imported
+		csrID := getPolicyIDFromPath(csrPath)
+		log.Printf("[INFO] Importing CA-signed Certificate for CSR %s", csrID)
+		client := infra.NewCsrsClient(connector)
+		cert, err := client.Importcsr(csrID, obj)
+		if err != nil {
+			return handleCreateError("Certificate", csrID, err)
+		}
+
+		d.SetId(*cert.Id)
+		d.Set("nsx_id", *cert.Id)
+		return resourceNsxtPolicyCertificateRead(d, m)
+	}
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCertificateExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Importing Certificate with ID %s", id)
+	client := infra.NewCertificatesClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Certificate", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	obj, err := client.Get(id, &details)
+	if err != nil {
+		return handleReadError(d, "Certificate", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// NSX may reformat PEM data, hence it is only set on import
+	if d.Get("pem_encoded").(string) == "" {
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+	d.Set("purpose", obj.Purpose)
+	d.Set("certificate_type", obj.TlsCertificateType)
+	d.Set("has_private_key", obj.HasPrivateKey)
+	setCertificateDetailsInSchema(d, obj.Details)
+
+	// Private key and passphrase are not returned by NSX, only their hash is kept in state
+	setSecretHashInSchema(d, "private_key")
+	setSecretHashInSchema(d, "passphrase")
+
+	return nil
+}
+
+func resourceNsxtPolicyCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCertificatesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Certificate", id, err)
+	}
+
+	return nil
+}
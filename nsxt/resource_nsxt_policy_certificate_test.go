@@ -0,0 +1,141 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+func TestAccResourceNsxtPolicyCertificate_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_certificate.test"
+	name := getAccTestResourceName()
+	certPem, keyPem, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(name, certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCertificateExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "test certificate"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "has_private_key", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "private_key"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(testResourceName, "sha256_thumbprint"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCertificate_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_certificate.test"
+	certPem, keyPem, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(name, certPem, keyPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded", "private_key"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCertificateExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Certificate resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Certificate resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCertificateExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Certificate %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCertificateCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_certificate" && rs.Type != "nsxt_policy_self_signed_certificate" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		client := infra.NewCertificatesClient(connector)
+		_, err := client.Get(resourceID, nil)
+		if err == nil {
+			return fmt.Errorf("Policy Certificate %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyCertificateTemplate(name string, certPem string, keyPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_certificate" "test" {
+  display_name = "%s"
+  description  = "test certificate"
+  pem_encoded  = <<-EOT
+%s
+  EOT
+  private_key  = <<-EOT
+%s
+  EOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, certPem, keyPem)
+}
@@ -313,15 +313,13 @@ func resourceNsxtPolicyContextProfileDelete(d *schema.ResourceData, m interface{
 	}
 
 	connector := getPolicyConnector(m)
-	var err error
 	force := true
-	client := infra.NewContextProfilesClient(getSessionContext(d, m), connector)
-	err = client.Delete(id, &force, nil)
-	if err != nil {
-		return handleDeleteError("ContextProfile", id, err)
+	doDelete := func() error {
+		client := infra.NewContextProfilesClient(getSessionContext(d, m), connector)
+		return client.Delete(id, &force, nil)
 	}
 
-	return nil
+	return policyDeleteWithReferrersCheck(d, m, "ContextProfile", doDelete)
 }
 
 func checkAttributesValid(context utl.SessionContext, attributes []interface{}, m interface{}, key string) error {
@@ -0,0 +1,178 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyCrl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCrlCreate,
+		Read:   resourceNsxtPolicyCrlRead,
+		Update: resourceNsxtPolicyCrlUpdate,
+		Delete: resourceNsxtPolicyCrlDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:         schema.TypeString,
+				Description:  "PEM encoded X509 CRL data",
+				Optional:     true,
+				ExactlyOneOf: []string{"pem_encoded", "one_crl"},
+			},
+			"one_crl": {
+				Type:        schema.TypeString,
+				Description: "JSON encoded OneCRL-like object",
+				Optional:    true,
+			},
+			"crl_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the CRL",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCrlExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCrlsClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyCrlPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+	oneCrl := d.Get("one_crl").(string)
+
+	obj := model.TlsCrl{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+	}
+	if pemEncoded != "" {
+		crlType := model.TlsCrl_CRL_TYPE_X509
+		obj.CrlType = &crlType
+		obj.PemEncoded = &pemEncoded
+	} else {
+		crlType := model.TlsCrl_CRL_TYPE_ONECRL
+		obj.CrlType = &crlType
+		obj.OneCrl = &oneCrl
+	}
+
+	log.Printf("[INFO] Patching Crl with ID %s", id)
+	client := infra.NewCrlsClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyCrlCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCrlExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyCrlPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Crl", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Crl ID")
+	}
+
+	client := infra.NewCrlsClient(connector)
+	obj, err := client.Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "Crl", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("crl_type", obj.CrlType)
+	// NSX may reformat CRL data, hence it is only set on import
+	if d.Get("pem_encoded").(string) == "" && d.Get("one_crl").(string) == "" {
+		d.Set("pem_encoded", obj.PemEncoded)
+		d.Set("one_crl", obj.OneCrl)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyCrlUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Crl ID")
+	}
+
+	err := resourceNsxtPolicyCrlPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Crl", id, err)
+	}
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Crl ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCrlsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Crl", id, err)
+	}
+
+	return nil
+}
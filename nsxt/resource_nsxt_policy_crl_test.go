@@ -0,0 +1,194 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyCrl_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_crl.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	crlPem, err := testAccGenerateCrl()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCrlCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(name, crlPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCrlExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "crl_type", "X509"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCrlTemplate(updatedName, crlPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCrlExists(updatedName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "crl_type", "X509"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCrl_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_crl.test"
+	crlPem, err := testAccGenerateCrl()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCrlCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(name, crlPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded", "one_crl"},
+			},
+		},
+	})
+}
+
+// Generate PEM encoded CRL signed by an ad-hoc CA
+func testAccGenerateCrl() (string, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P384(), cryptorand.Reader)
+	if err != nil {
+		return "", err
+	}
+	issuer := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			Organization: []string{"Acme Co"},
+		},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour * 24 * 180),
+		KeyUsage:              x509.KeyUsageCRLSign | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          []byte{1, 2, 3, 4},
+	}
+	template := x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour * 24 * 30),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{
+				SerialNumber:   big.NewInt(2),
+				RevocationTime: time.Now(),
+			},
+		},
+	}
+
+	derBytes, err := x509.CreateRevocationList(cryptorand.Reader, &template, &issuer, priv)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := pem.Encode(buf, &pem.Block{Type: "X509 CRL", Bytes: derBytes}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func testAccNsxtPolicyCrlExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Crl resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Crl resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCrlExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Crl %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCrlCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_crl" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyCrlExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Crl %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyCrlTemplate(name string, crlPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_crl" "test" {
+  display_name = "%s"
+  pem_encoded  = <<-EOT
+%s
+  EOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, crlPem)
+}
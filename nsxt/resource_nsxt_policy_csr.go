@@ -0,0 +1,156 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyCsr() *schema.Resource {
+	s := getCertificateKeySchema()
+	s["nsx_id"] = getNsxIDSchema()
+	s["path"] = getPathSchema()
+	s["display_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Display name for this resource",
+		Required:    true,
+		ForceNew:    true,
+	}
+	s["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Description for this resource",
+		Optional:    true,
+		ForceNew:    true,
+	}
+	s["tag"] = getTagsSchemaForceNew()
+	s["pem_encoded"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "PEM encoded certificate signing request, to be signed by CA",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCsrCreate,
+		Read:   resourceNsxtPolicyCsrRead,
+		Delete: resourceNsxtPolicyCsrDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceNsxtPolicyCsrExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCsrsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyCsrCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCsrExists)
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	algorithm := d.Get("algorithm").(string)
+	keySize := int64(d.Get("key_size").(int))
+	isCa := d.Get("is_ca").(bool)
+
+	obj := model.TlsCsr{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Algorithm:   &algorithm,
+		KeySize:     &keySize,
+		IsCa:        &isCa,
+		Subject:     getCertificateSubjectFromSchema(d),
+	}
+
+	log.Printf("[INFO] Creating Csr with ID %s", id)
+	client := infra.NewCsrsClient(connector)
+	_, err = client.Create(id, obj)
+	if err != nil {
+		return handleCreateError("Csr", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCsrRead(d, m)
+}
+
+func resourceNsxtPolicyCsrRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Csr ID")
+	}
+
+	client := infra.NewCsrsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		if isNotFoundError(err) && d.Get("pem_encoded").(string) != "" {
+			// NSX removes the CSR once signed certificate is imported for it. This is
+			// expected lifecycle, and should not trigger re-creation of the CSR.
+			log.Printf("[INFO] Csr %s was removed from NSX, keeping last known state", id)
+			return nil
+		}
+		return handleReadError(d, "Csr", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+
+	d.Set("algorithm", obj.Algorithm)
+	d.Set("key_size", obj.KeySize)
+	d.Set("is_ca", obj.IsCa)
+	setCertificateSubjectInSchema(d, obj.Subject)
+	d.Set("pem_encoded", obj.PemEncoded)
+
+	return nil
+}
+
+func resourceNsxtPolicyCsrDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Csr ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCsrsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Csr", id, err)
+	}
+
+	return nil
+}
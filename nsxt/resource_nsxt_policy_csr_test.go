@@ -0,0 +1,135 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyCsr_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_csr.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCsrCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCsrTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCsrExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "algorithm", "RSA"),
+					resource.TestCheckResourceAttr(testResourceName, "key_size", "3072"),
+					resource.TestCheckResourceAttr(testResourceName, "is_ca", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "subject.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "subject.0.common_name", "test.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "subject.0.organization", "Acme Co"),
+					resource.TestCheckResourceAttr(testResourceName, "subject.0.country", "US"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pem_encoded"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCsr_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_csr.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCsrCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCsrTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCsrExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Csr resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Csr resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCsrExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Csr %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCsrCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_csr" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyCsrExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Csr %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyCsrTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_csr" "test" {
+  display_name = "%s"
+  key_size     = 3072
+
+  subject {
+    common_name  = "test.example.com"
+    organization = "Acme Co"
+    country      = "US"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
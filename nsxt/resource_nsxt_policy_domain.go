@@ -25,7 +25,7 @@ func resourceNsxtPolicyDomain() *schema.Resource {
 		Update: resourceNsxtPolicyDomainUpdate,
 		Delete: resourceNsxtPolicyDomainDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
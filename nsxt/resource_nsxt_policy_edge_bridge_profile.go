@@ -0,0 +1,311 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var edgeBridgeProfileFailoverModeValues = []string{
+	model.L2BridgeEndpointProfile_FAILOVER_MODE_PREEMPTIVE,
+	model.L2BridgeEndpointProfile_FAILOVER_MODE_NON_PREEMPTIVE,
+}
+
+var edgeBridgeProfileHaModeValues = []string{
+	model.L2BridgeEndpointProfile_HA_MODE_STANDBY,
+}
+
+func resourceNsxtPolicyEdgeBridgeProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyEdgeBridgeProfileCreate,
+		Read:   resourceNsxtPolicyEdgeBridgeProfileRead,
+		Update: resourceNsxtPolicyEdgeBridgeProfileUpdate,
+		Delete: resourceNsxtPolicyEdgeBridgeProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyEdgeBridgeProfileImporter,
+		},
+		CustomizeDiff: resourceNsxtPolicyEdgeBridgeProfileCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"edge_cluster_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of the edge cluster for this bridge profile",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"primary_edge_node_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of the primary edge node for L2 bridging",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"backup_edge_node_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of the backup edge node for L2 bridging",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"failover_mode": {
+				Type:         schema.TypeString,
+				Description:  "Failover mode for the edge bridge cluster",
+				Optional:     true,
+				Default:      model.L2BridgeEndpointProfile_FAILOVER_MODE_PREEMPTIVE,
+				ValidateFunc: validation.StringInSlice(edgeBridgeProfileFailoverModeValues, false),
+			},
+			"ha_mode": {
+				Type:         schema.TypeString,
+				Description:  "High availability mode, can not be modified after realization",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.L2BridgeEndpointProfile_HA_MODE_STANDBY,
+				ValidateFunc: validation.StringInSlice(edgeBridgeProfileHaModeValues, false),
+			},
+		},
+	}
+}
+
+// Bridge profile is created under the site and enforcement point of its edge cluster.
+// During import, edge cluster path is not known yet, and object path is used instead.
+func getPolicyEdgeBridgeProfileSiteAndEP(d *schema.ResourceData) (string, string, error) {
+	parentPath := d.Get("edge_cluster_path").(string)
+	if parentPath == "" {
+		parentPath = d.Get("path").(string)
+	}
+
+	siteID := getResourceIDFromResourcePath(parentPath, "sites")
+	epID := getResourceIDFromResourcePath(parentPath, "enforcement-points")
+	if siteID == "" || epID == "" {
+		return "", "", fmt.Errorf("failed to determine site and enforcement point from path %s", parentPath)
+	}
+
+	return siteID, epID, nil
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileExists(siteID string, epID string, id string, connector client.Connector) (bool, error) {
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	_, err := client.Get(siteID, epID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func validatePolicyEdgeBridgeProfileEdgeNodes(edgeClusterPath string, primaryPath string, backupPath string) error {
+	edgeNodePaths := []string{primaryPath}
+	if backupPath != "" {
+		if backupPath == primaryPath {
+			return fmt.Errorf("backup edge node %s should differ from primary edge node", backupPath)
+		}
+		edgeNodePaths = append(edgeNodePaths, backupPath)
+	}
+
+	prefix := strings.TrimSuffix(edgeClusterPath, "/") + "/edge-nodes/"
+	for _, nodePath := range edgeNodePaths {
+		if !strings.HasPrefix(nodePath, prefix) {
+			return fmt.Errorf("edge node %s does not belong to edge cluster %s", nodePath, edgeClusterPath)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	for _, attr := range []string{"edge_cluster_path", "primary_edge_node_path", "backup_edge_node_path"} {
+		if !diff.NewValueKnown(attr) {
+			return nil
+		}
+	}
+
+	edgeClusterPath := diff.Get("edge_cluster_path").(string)
+	primaryPath := diff.Get("primary_edge_node_path").(string)
+	backupPath := diff.Get("backup_edge_node_path").(string)
+	return validatePolicyEdgeBridgeProfileEdgeNodes(edgeClusterPath, primaryPath, backupPath)
+}
+
+func resourceNsxtPolicyEdgeBridgeProfilePatch(d *schema.ResourceData, m interface{}, siteID string, epID string, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	failoverMode := d.Get("failover_mode").(string)
+	haMode := d.Get("ha_mode").(string)
+
+	edgePaths := []string{d.Get("primary_edge_node_path").(string)}
+	backupPath := d.Get("backup_edge_node_path").(string)
+	if backupPath != "" {
+		edgePaths = append(edgePaths, backupPath)
+	}
+
+	obj := model.L2BridgeEndpointProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		EdgePaths:    edgePaths,
+		FailoverMode: &failoverMode,
+		HaMode:       &haMode,
+	}
+
+	log.Printf("[INFO] Patching EdgeBridgeProfile with ID %s under site %s enforcement point %s", id, siteID, epID)
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	return client.Patch(siteID, epID, id, obj)
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	siteID, epID, err := getPolicyEdgeBridgeProfileSiteAndEP(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyEdgeBridgeProfileExists(siteID, epID, id, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with ID %s already exists", id)
+		}
+	}
+
+	err = resourceNsxtPolicyEdgeBridgeProfilePatch(d, m, siteID, epID, id)
+	if err != nil {
+		return handleCreateError("EdgeBridgeProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyEdgeBridgeProfileRead(d, m)
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining EdgeBridgeProfile ID")
+	}
+
+	siteID, epID, err := getPolicyEdgeBridgeProfileSiteAndEP(d)
+	if err != nil {
+		return err
+	}
+
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	obj, err := client.Get(siteID, epID, id)
+	if err != nil {
+		return handleReadError(d, "EdgeBridgeProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("failover_mode", obj.FailoverMode)
+	d.Set("ha_mode", obj.HaMode)
+
+	primaryPath := ""
+	backupPath := ""
+	if len(obj.EdgePaths) > 0 {
+		primaryPath = obj.EdgePaths[0]
+		if d.Get("edge_cluster_path").(string) == "" {
+			// Edge cluster is not part of the NSX object, and is set from edge node path on import
+			clusterPath, err := getAncestorPathFromResourcePath(primaryPath, "edge-clusters")
+			if err != nil {
+				return handleReadError(d, "EdgeBridgeProfile", id, err)
+			}
+			d.Set("edge_cluster_path", clusterPath)
+		}
+	}
+	if len(obj.EdgePaths) > 1 {
+		backupPath = obj.EdgePaths[1]
+	}
+	d.Set("primary_edge_node_path", primaryPath)
+	d.Set("backup_edge_node_path", backupPath)
+
+	return nil
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining EdgeBridgeProfile ID")
+	}
+
+	siteID, epID, err := getPolicyEdgeBridgeProfileSiteAndEP(d)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyEdgeBridgeProfilePatch(d, m, siteID, epID, id)
+	if err != nil {
+		return handleUpdateError("EdgeBridgeProfile", id, err)
+	}
+
+	return resourceNsxtPolicyEdgeBridgeProfileRead(d, m)
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining EdgeBridgeProfile ID")
+	}
+
+	siteID, epID, err := getPolicyEdgeBridgeProfileSiteAndEP(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	err = client.Delete(siteID, epID, id)
+	if err != nil {
+		return handleDeleteError("EdgeBridgeProfile", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyEdgeBridgeProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return rd, fmt.Errorf("Edge bridge profile should be imported by policy path, got %s", importID)
+	}
+
+	d.Set("path", importID)
+	return rd, nil
+}
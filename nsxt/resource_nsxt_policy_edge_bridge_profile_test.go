@@ -0,0 +1,213 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyEdgeBridgeProfileCreateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform created",
+	"failover_mode": "PREEMPTIVE",
+}
+
+var accTestPolicyEdgeBridgeProfileUpdateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform updated",
+	"failover_mode": "NON_PREEMPTIVE",
+}
+
+func TestAccResourceNsxtPolicyEdgeBridgeProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_edge_bridge_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyEdgeBridgeProfileCheckDestroy(state, accTestPolicyEdgeBridgeProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeBridgeProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyEdgeBridgeProfileExists(accTestPolicyEdgeBridgeProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyEdgeBridgeProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyEdgeBridgeProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "failover_mode", accTestPolicyEdgeBridgeProfileCreateAttributes["failover_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_mode", "ACTIVE_STANDBY"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "primary_edge_node_path"),
+					resource.TestCheckResourceAttr(testResourceName, "backup_edge_node_path", ""),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyEdgeBridgeProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyEdgeBridgeProfileExists(accTestPolicyEdgeBridgeProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyEdgeBridgeProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyEdgeBridgeProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "failover_mode", accTestPolicyEdgeBridgeProfileUpdateAttributes["failover_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_mode", "ACTIVE_STANDBY"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "primary_edge_node_path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyEdgeBridgeProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_edge_bridge_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyEdgeBridgeProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeBridgeProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyEdgeBridgeProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy EdgeBridgeProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy EdgeBridgeProfile resource ID not set in resources")
+		}
+
+		path := rs.Primary.Attributes["path"]
+		siteID := getResourceIDFromResourcePath(path, "sites")
+		epID := getResourceIDFromResourcePath(path, "enforcement-points")
+		exists, err := resourceNsxtPolicyEdgeBridgeProfileExists(siteID, epID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy EdgeBridgeProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyEdgeBridgeProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_edge_bridge_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		path := rs.Primary.Attributes["path"]
+		siteID := getResourceIDFromResourcePath(path, "sites")
+		epID := getResourceIDFromResourcePath(path, "enforcement-points")
+		exists, err := resourceNsxtPolicyEdgeBridgeProfileExists(siteID, epID, resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy EdgeBridgeProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyEdgeBridgeProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyEdgeBridgeProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyEdgeBridgeProfileUpdateAttributes
+	}
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+data "nsxt_policy_edge_node" "test" {
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  member_index      = 0
+}
+
+resource "nsxt_policy_edge_bridge_profile" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  edge_cluster_path      = data.nsxt_policy_edge_cluster.test.path
+  primary_edge_node_path = data.nsxt_policy_edge_node.test.path
+  failover_mode          = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["failover_mode"])
+}
+
+func TestValidatePolicyEdgeBridgeProfileEdgeNodes(t *testing.T) {
+	clusterPath := "/infra/sites/default/enforcement-points/default/edge-clusters/ec1"
+	node1 := clusterPath + "/edge-nodes/0"
+	node2 := clusterPath + "/edge-nodes/1"
+	otherNode := "/infra/sites/default/enforcement-points/default/edge-clusters/ec2/edge-nodes/0"
+
+	tests := []struct {
+		name        string
+		clusterPath string
+		primary     string
+		backup      string
+		expectErr   bool
+	}{
+		{name: "primary only", clusterPath: clusterPath, primary: node1},
+		{name: "primary and backup", clusterPath: clusterPath, primary: node1, backup: node2},
+		{name: "cluster path with trailing slash", clusterPath: clusterPath + "/", primary: node1, backup: node2},
+		{name: "primary in other cluster", clusterPath: clusterPath, primary: otherNode, expectErr: true},
+		{name: "backup in other cluster", clusterPath: clusterPath, primary: node1, backup: otherNode, expectErr: true},
+		{name: "backup same as primary", clusterPath: clusterPath, primary: node1, backup: node1, expectErr: true},
+		{name: "cluster path prefix of another cluster", clusterPath: clusterPath, primary: clusterPath + "0/edge-nodes/0", expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validatePolicyEdgeBridgeProfileEdgeNodes(test.clusterPath, test.primary, test.backup)
+			if test.expectErr && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !test.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -201,9 +201,13 @@ func resourceNsxtPolicyEvpnTunnelEndpointDelete(d *schema.ResourceData, m interf
 
 func resourceNsxtPolicyEvpnTunnelEndpointImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	if isPolicyPath(importID) {
+		return resourceNsxtPolicyEvpnTunnelEndpointImportByPath(d, m, importID)
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) != 4 {
-		return nil, fmt.Errorf("Please provide gateway-id/locale-service-id/interface-id/endpoint-id as an input")
+		return nil, fmt.Errorf("Please provide gateway-id/locale-service-id/interface-id/endpoint-id or endpoint policy path as an input")
 	}
 
 	gwID := s[0]
@@ -218,3 +222,48 @@ func resourceNsxtPolicyEvpnTunnelEndpointImport(d *schema.ResourceData, m interf
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// Endpoint path does not contain the external interface, hence the interface is
+// looked up by edge node of the endpoint
+func resourceNsxtPolicyEvpnTunnelEndpointImportByPath(d *schema.ResourceData, m interface{}, importPath string) ([]*schema.ResourceData, error) {
+	gwID := getResourceIDFromResourcePath(importPath, "tier-0s")
+	localeServiceID := getResourceIDFromResourcePath(importPath, "locale-services")
+	id := getPolicyIDFromPath(importPath)
+	if gwID == "" || localeServiceID == "" {
+		return nil, fmt.Errorf("EVPN Tunnel Endpoint path expected, got %s", importPath)
+	}
+
+	connector := getPolicyConnector(m)
+	obj, err := locale_services.NewEvpnTunnelEndpointsClient(connector).Get(gwID, localeServiceID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces, err := locale_services.NewInterfacesClient(connector).List(gwID, localeServiceID, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	interfacePath := ""
+	for _, intf := range interfaces.Results {
+		if intf.EdgePath == nil || obj.EdgePath == nil || *intf.EdgePath != *obj.EdgePath {
+			continue
+		}
+		if intf.Type_ != nil && *intf.Type_ != model.Tier0Interface_TYPE_EXTERNAL {
+			continue
+		}
+		if interfacePath != "" {
+			return nil, fmt.Errorf("Multiple external interfaces found for EVPN Tunnel Endpoint %s, please provide gateway-id/locale-service-id/interface-id/endpoint-id as an input", importPath)
+		}
+		interfacePath = *intf.Path
+	}
+	if interfacePath == "" {
+		return nil, fmt.Errorf("Failed to find external interface for EVPN Tunnel Endpoint %s", importPath)
+	}
+
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+	d.Set("external_interface_path", interfacePath)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,202 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyFirewallDraft() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallDraftCreate,
+		Read:   resourceNsxtPolicyFirewallDraftRead,
+		Update: resourceNsxtPolicyFirewallDraftUpdate,
+		Delete: resourceNsxtPolicyFirewallDraftDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(),
+			"ref_draft_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the draft this draft is based on. If not specified, draft is created from current published configuration",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Lock the draft, so that other users would not be able to modify or publish it",
+				Optional:    true,
+				Default:     false,
+			},
+			"lock_comments": {
+				Type:        schema.TypeString,
+				Description: "Comments for the draft lock or unlock",
+				Optional:    true,
+			},
+			"lock_modified_by": {
+				Type:        schema.TypeString,
+				Description: "ID of the user who last modified the lock",
+				Computed:    true,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Description: "Creation time of the draft",
+				Computed:    true,
+			},
+			"create_user": {
+				Type:        schema.TypeString,
+				Description: "ID of the user who created the draft",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallDraftExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewDraftsClient(sessionContext, connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyFirewallDraftPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	locked := d.Get("locked").(bool)
+	lockComments := d.Get("lock_comments").(string)
+
+	obj := model.PolicyDraft{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Locked:      &locked,
+	}
+
+	if lockComments != "" {
+		obj.LockComments = &lockComments
+	}
+
+	refDraftPath := d.Get("ref_draft_path").(string)
+	if refDraftPath != "" {
+		obj.RefDraftPath = &refDraftPath
+	}
+
+	log.Printf("[INFO] Patching Firewall Draft with ID %s", id)
+	client := infra.NewDraftsClient(getSessionContext(d, m), connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyFirewallDraftCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyFirewallDraftExists)
+	if err != nil {
+		return err
+	}
+
+	// Without user_area, NSX creates the draft as a snapshot of current configuration
+	err = resourceNsxtPolicyFirewallDraftPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Firewall Draft", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallDraftRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft ID")
+	}
+
+	client := infra.NewDraftsClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Firewall Draft", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ref_draft_path", obj.RefDraftPath)
+	d.Set("locked", obj.Locked)
+	d.Set("lock_comments", obj.LockComments)
+	d.Set("lock_modified_by", obj.LockModifiedBy)
+	d.Set("create_time", policyTimestampToString(obj.CreateTime))
+	d.Set("create_user", obj.CreateUser)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallDraftUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft ID")
+	}
+
+	err := resourceNsxtPolicyFirewallDraftPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Firewall Draft", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallDraftRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(getSessionContext(d, m), connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Firewall Draft", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyFirewallDraftPublish() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallDraftPublishCreate,
+		Read:   resourceNsxtPolicyFirewallDraftPublishRead,
+		Delete: resourceNsxtPolicyFirewallDraftPublishDelete,
+
+		Schema: map[string]*schema.Schema{
+			"context": getContextSchema(),
+			"draft_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the draft to publish",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+		},
+	}
+}
+
+func publishPolicyFirewallDraft(connector client.Connector, context utl.SessionContext, draftID string) error {
+	// No additional changes are applied on top of the draft
+	changes := model.Infra{}
+	client := infra.NewDraftsClient(context, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Publish(draftID, changes)
+}
+
+func resourceNsxtPolicyFirewallDraftPublishCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	draftPath := d.Get("draft_path").(string)
+	if !strings.Contains(draftPath, "/drafts/") {
+		return fmt.Errorf("%s is not a valid Firewall Draft path", draftPath)
+	}
+
+	id := newUUID()
+	log.Printf("[INFO] Publishing Firewall Draft %s", draftPath)
+	err := publishPolicyFirewallDraft(getPolicyConnector(m), getSessionContext(d, m), getPolicyIDFromPath(draftPath))
+	if err != nil {
+		return handleCreateError("Firewall Draft Publish", draftPath, err)
+	}
+
+	d.SetId(id)
+	return resourceNsxtPolicyFirewallDraftPublishRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftPublishRead(d *schema.ResourceData, m interface{}) error {
+	// Publish is a one-time action, there is no NSX object to read back
+	return nil
+}
+
+func resourceNsxtPolicyFirewallDraftPublishDelete(d *schema.ResourceData, m interface{}) error {
+	// Published configuration can not be reverted, other than by publishing another draft
+	return nil
+}
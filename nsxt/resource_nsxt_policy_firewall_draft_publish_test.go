@@ -0,0 +1,41 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyFirewallDraftPublish_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_draft_publish.test"
+
+	// Draft of current configuration is published, hence configuration is not affected
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftPublishTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "draft_path", "nsxt_policy_firewall_draft.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallDraftPublishTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_firewall_draft_publish" "test" {
+  draft_path = nsxt_policy_firewall_draft.test.path
+}`, getAccTestResourceName())
+}
@@ -0,0 +1,184 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyFirewallDraftCreateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform created",
+	"locked":        "true",
+	"lock_comments": "locked by terraform",
+}
+
+var accTestPolicyFirewallDraftUpdateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform updated",
+	"locked":        "false",
+	"lock_comments": "unlocked by terraform",
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_basic(t *testing.T) {
+	testAccResourceNsxtPolicyFirewallDraftBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyFirewallDraftBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyFirewallDraftBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_firewall_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, accTestPolicyFirewallDraftUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(true, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(accTestPolicyFirewallDraftCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallDraftCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallDraftCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "locked", accTestPolicyFirewallDraftCreateAttributes["locked"]),
+					resource.TestCheckResourceAttr(testResourceName, "lock_comments", accTestPolicyFirewallDraftCreateAttributes["lock_comments"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "create_time"),
+					resource.TestCheckResourceAttrSet(testResourceName, "create_user"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(false, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(accTestPolicyFirewallDraftUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallDraftUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallDraftUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "locked", accTestPolicyFirewallDraftUpdateAttributes["locked"]),
+					resource.TestCheckResourceAttr(testResourceName, "lock_comments", accTestPolicyFirewallDraftUpdateAttributes["lock_comments"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(true, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallDraftExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy FirewallDraft resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy FirewallDraft resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyFirewallDraftExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy FirewallDraft %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallDraftCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_firewall_draft" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyFirewallDraftExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy FirewallDraft %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallDraftTemplate(createFlow bool, withContext bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyFirewallDraftCreateAttributes
+	} else {
+		attrMap = accTestPolicyFirewallDraftUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+%s
+  display_name  = "%s"
+  description   = "%s"
+  locked        = %s
+  lock_comments = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["locked"], attrMap["lock_comments"])
+}
@@ -58,6 +58,10 @@ func resourceNsxtPolicyFirewallExcludeListMemberExists(sessionContext utl.Sessio
 	return false, nil
 }
 
+func getPolicyExcludeListPath(context utl.SessionContext) string {
+	return getPolicyInfraPath(context) + "/settings/firewall/security/exclude-list"
+}
+
 func resourceNsxtPolicyFirewallExcludeListMemberCreate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 	member := d.Get("member").(string)
@@ -87,6 +91,10 @@ func resourceNsxtPolicyFirewallExcludeListMemberCreate(d *schema.ResourceData, m
 
 		return nil
 	}
+	lockPath := getPolicyExcludeListPath(getSessionContext(d, m))
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
@@ -135,6 +143,10 @@ func resourceNsxtPolicyFirewallExcludeListMemberDelete(d *schema.ResourceData, m
 		_, err = client.Update(obj)
 		return err
 	}
+	lockPath := getPolicyExcludeListPath(getSessionContext(d, m))
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
@@ -0,0 +1,319 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	t1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Arguments only applicable to Tier0 and VRF gateways
+var policyTier0MulticastConfigAttributes = []string{"igmp_profile_path", "pim_profile_path", "replication_multicast_range"}
+
+func resourceNsxtPolicyGatewayMulticastConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGatewayMulticastConfigCreate,
+		Read:   resourceNsxtPolicyGatewayMulticastConfigRead,
+		Update: resourceNsxtPolicyGatewayMulticastConfigUpdate,
+		Delete: resourceNsxtPolicyGatewayMulticastConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyGatewayMulticastConfigImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for Tier0, VRF or Tier1 gateway"),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable multicast on the gateway",
+				Optional:    true,
+				Default:     true,
+			},
+			"igmp_profile_path": getComputedPolicyPathSchema("Policy path of IGMP profile, only applicable to Tier0 and VRF gateways"),
+			"pim_profile_path":  getComputedPolicyPathSchema("Policy path of PIM profile, only applicable to Tier0 and VRF gateways"),
+			"replication_multicast_range": {
+				Type:         schema.TypeString,
+				Description:  "Multicast range used for replication of multicast traffic in the overlay, only applicable to Tier0 and VRF gateways",
+				Optional:     true,
+				ValidateFunc: validateCidr(),
+			},
+			"locale_service_id": {
+				Type:        schema.TypeString,
+				Description: "Id of associated Gateway Locale Service on NSX",
+				Computed:    true,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Description: "Id of associated Gateway on NSX",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func validatePolicyTier1MulticastConfig(d *schema.ResourceData) error {
+	for _, attr := range policyTier0MulticastConfigAttributes {
+		if d.Get(attr).(string) != "" {
+			return fmt.Errorf("%s is only applicable to Tier0 and VRF gateways", attr)
+		}
+	}
+	return nil
+}
+
+func validatePolicyTier1MulticastSupport() error {
+	if !nsxVersionHigherOrEqual("4.1.0") {
+		return fmt.Errorf("Multicast on Tier1 gateway requires NSX version 4.1.0 or higher")
+	}
+	return nil
+}
+
+func getPolicyGatewayMulticastConfigLockPath(context utl.SessionContext, isT0 bool, gwID string, localeServiceID string) string {
+	if isT0 {
+		return getPolicyTier0LocaleServiceLockPath(context, gwID, localeServiceID)
+	}
+	return getPolicyLocaleServiceLockPath(fmt.Sprintf("%s/tier-1s/%s", getPolicyInfraPath(context), gwID), localeServiceID)
+}
+
+func policyGatewayMulticastConfigPatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string) error {
+	connector := getPolicyConnector(m)
+
+	// Multicast config is part of the locale service, hence concurrent modifications
+	// of the locale service are serialized
+	lockPath := getPolicyGatewayMulticastConfigLockPath(getSessionContext(d, m), isT0, gwID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
+	enabled := d.Get("enabled").(bool)
+	if !isT0 {
+		obj := model.PolicyTier1MulticastConfig{
+			Enabled: &enabled,
+		}
+		client := t1_locale_services.NewMulticastClient(connector)
+		return client.Patch(gwID, localeServiceID, obj)
+	}
+
+	// Config is replaced rather than patched, so that attributes removed from
+	// configuration are cleared on NSX
+	client := locale_services.NewMulticastClient(connector)
+	doUpdate := func() error {
+		obj, err := client.Get(gwID, localeServiceID)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		obj.Enabled = &enabled
+		obj.IgmpProfilePath = nil
+		obj.PimProfilePath = nil
+		obj.ReplicationMulticastRange = nil
+		igmpProfilePath := d.Get("igmp_profile_path").(string)
+		if igmpProfilePath != "" {
+			obj.IgmpProfilePath = &igmpProfilePath
+		}
+		pimProfilePath := d.Get("pim_profile_path").(string)
+		if pimProfilePath != "" {
+			obj.PimProfilePath = &pimProfilePath
+		}
+		replicationRange := d.Get("replication_multicast_range").(string)
+		if replicationRange != "" {
+			obj.ReplicationMulticastRange = &replicationRange
+		}
+		_, err = client.Update(gwID, localeServiceID, obj)
+		return err
+	}
+	commonProviderConfig := getCommonProviderConfig(m)
+	return retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("Gateway path expected, got %s", gwPath)
+	}
+
+	var localeService *model.LocaleServices
+	var err error
+	if isT0 {
+		localeService, err = getPolicyTier0GatewayLocaleServiceWithEdgeCluster(context, gwID, connector)
+	} else {
+		if err := validatePolicyTier1MulticastSupport(); err != nil {
+			return err
+		}
+		if err := validatePolicyTier1MulticastConfig(d); err != nil {
+			return err
+		}
+		localeService, err = getPolicyTier1GatewayLocaleServiceEntry(context, gwID, connector)
+	}
+	if err != nil {
+		return err
+	}
+	if localeService == nil {
+		return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to configure multicast", gwID)
+	}
+	localeServiceID := *localeService.Id
+
+	id := newUUID()
+	err = policyGatewayMulticastConfigPatch(d, m, isT0, gwID, localeServiceID)
+	if err != nil {
+		return handleCreateError("Gateway Multicast Config", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+
+	return resourceNsxtPolicyGatewayMulticastConfigRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+	if id == "" || gwID == "" || localeServiceID == "" {
+		return fmt.Errorf("Error obtaining Gateway id or Locale Service id")
+	}
+
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	if !isT0 {
+		if err := validatePolicyTier1MulticastSupport(); err != nil {
+			return err
+		}
+		client := t1_locale_services.NewMulticastClient(connector)
+		obj, err := client.Get(gwID, localeServiceID)
+		if err != nil {
+			return handleReadError(d, "Gateway Multicast Config", id, err)
+		}
+		d.Set("enabled", obj.Enabled)
+		return nil
+	}
+
+	client := locale_services.NewMulticastClient(connector)
+	obj, err := client.Get(gwID, localeServiceID)
+	if err != nil {
+		return handleReadError(d, "Gateway Multicast Config", id, err)
+	}
+
+	d.Set("enabled", obj.Enabled)
+	d.Set("igmp_profile_path", obj.IgmpProfilePath)
+	d.Set("pim_profile_path", obj.PimProfilePath)
+	d.Set("replication_multicast_range", obj.ReplicationMulticastRange)
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+	if id == "" || gwID == "" || localeServiceID == "" {
+		return fmt.Errorf("Error obtaining Gateway id or Locale Service id")
+	}
+
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	if !isT0 {
+		if err := validatePolicyTier1MulticastSupport(); err != nil {
+			return err
+		}
+		if err := validatePolicyTier1MulticastConfig(d); err != nil {
+			return err
+		}
+	}
+
+	err := policyGatewayMulticastConfigPatch(d, m, isT0, gwID, localeServiceID)
+	if err != nil {
+		return handleUpdateError("Gateway Multicast Config", id, err)
+	}
+
+	return resourceNsxtPolicyGatewayMulticastConfigRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+	if id == "" || gwID == "" || localeServiceID == "" {
+		return fmt.Errorf("Error obtaining Gateway id or Locale Service id")
+	}
+
+	// Multicast config can not be removed, hence it is disabled with profiles reverted to defaults
+	enabled := false
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	lockPath := getPolicyGatewayMulticastConfigLockPath(getSessionContext(d, m), isT0, gwID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
+	var err error
+	if isT0 {
+		doUpdate := func() error {
+			client := locale_services.NewMulticastClient(connector)
+			obj, err := client.Get(gwID, localeServiceID)
+			if err != nil {
+				return err
+			}
+			obj.Enabled = &enabled
+			obj.IgmpProfilePath = nil
+			obj.PimProfilePath = nil
+			obj.ReplicationMulticastRange = nil
+			_, err = client.Update(gwID, localeServiceID, obj)
+			return err
+		}
+		commonProviderConfig := getCommonProviderConfig(m)
+		err = retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+	} else {
+		client := t1_locale_services.NewMulticastClient(connector)
+		err = client.Patch(gwID, localeServiceID, model.PolicyTier1MulticastConfig{Enabled: &enabled})
+	}
+
+	if err != nil {
+		return handleDeleteError("Gateway Multicast Config", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	// Locale service path, such as /infra/tier-0s/gw1/locale-services/default
+	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(importID)
+	if err != nil {
+		return nil, fmt.Errorf("Gateway Locale Service path expected, got %s", importID)
+	}
+	if !isT0 {
+		if err := validatePolicyTier1MulticastSupport(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Gateway path prefix is taken from the imported path rather than assumed
+	gwPath := importID[:strings.LastIndex(importID, "/locale-services/")]
+	if isPolicyGlobalManager(m) || strings.HasPrefix(gwPath, "/global-infra/") {
+		return nil, localManagerOnlyError()
+	}
+	if getProjectIDFromResourcePath(gwPath) != "" {
+		return nil, fmt.Errorf("Multicast config is not supported for project gateway %s", gwPath)
+	}
+
+	d.Set("gateway_path", gwPath)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+
+	d.SetId(newUUID())
+
+	return []*schema.ResourceData{d}, nil
+}
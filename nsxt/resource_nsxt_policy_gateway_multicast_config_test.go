@@ -0,0 +1,113 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+)
+
+func TestAccResourceNsxtPolicyGatewayMulticastConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_gateway_multicast_config.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayMulticastConfigTemplate(name, true, "239.1.1.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayMulticastConfigEnabled(testResourceName, true),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "replication_multicast_range", "239.1.1.0/24"),
+					resource.TestCheckResourceAttrPair(testResourceName, "pim_profile_path", "nsxt_policy_pim_profile.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "igmp_profile_path", "nsxt_policy_igmp_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_id"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayMulticastConfigTemplate(name, false, "239.1.2.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayMulticastConfigEnabled(testResourceName, false),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "replication_multicast_range", "239.1.2.0/24"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyGatewayMulticastConfigImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayMulticastConfigImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_gateway_multicast_config.test"]
+	if !ok {
+		return "", fmt.Errorf("Gateway Multicast Config resource not found in resources")
+	}
+	gwPath := rs.Primary.Attributes["gateway_path"]
+	localeServiceID := rs.Primary.Attributes["locale_service_id"]
+	if gwPath == "" || localeServiceID == "" {
+		return "", fmt.Errorf("Gateway Multicast Config attributes not set in resources")
+	}
+	return fmt.Sprintf("%s/locale-services/%s", gwPath, localeServiceID), nil
+}
+
+func testAccNsxtPolicyGatewayMulticastConfigEnabled(resourceName string, enabled bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Gateway Multicast Config resource %s not found in resources", resourceName)
+		}
+
+		gwID := rs.Primary.Attributes["gateway_id"]
+		localeServiceID := rs.Primary.Attributes["locale_service_id"]
+		client := locale_services.NewMulticastClient(connector)
+		obj, err := client.Get(gwID, localeServiceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving multicast config for gateway %s: %v", gwID, err)
+		}
+		if obj.Enabled == nil || *obj.Enabled != enabled {
+			return fmt.Errorf("Multicast enablement on gateway %s does not match %v", gwID, enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGatewayMulticastConfigTemplate(name string, enabled bool, replicationRange string) string {
+	return testAccNsxtPolicyGatewayFabricDeps(false) + fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+}
+
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_gateway_multicast_config" "test" {
+  gateway_path                = nsxt_policy_tier0_gateway.test.path
+  enabled                     = %v
+  pim_profile_path            = nsxt_policy_pim_profile.test.path
+  igmp_profile_path           = nsxt_policy_igmp_profile.test.path
+  replication_multicast_range = "%s"
+}`, name, name, name, enabled, replicationRange)
+}
@@ -26,7 +26,7 @@ func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
 			State: nsxtDomainResourceImporter,
 		},
 
-		Schema: getPolicyGatewayPolicySchema(),
+		Schema: getPolicyGatewayPolicySchema(true),
 	}
 }
 
@@ -121,9 +121,7 @@ func getUpdatedRuleChildren(d *schema.ResourceData) ([]*data.StructValue, error)
 
 }
 
-func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, connector client.Connector, isGlobalManager bool, id string) error {
-
-	domain := d.Get("domain").(string)
+func parentGatewayPolicySchemaToModel(d *schema.ResourceData, id string) model.GatewayPolicy {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	tags := getPolicyTagsFromSchema(d)
@@ -132,7 +130,6 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 	locked := d.Get("locked").(bool)
 	sequenceNumber := int64(d.Get("sequence_number").(int))
 	stateful := d.Get("stateful").(bool)
-	revision := int64(d.Get("revision").(int))
 	objType := "GatewayPolicy"
 
 	obj := model.GatewayPolicy{
@@ -153,53 +150,20 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 		obj.TcpStrict = &tcpStrict
 	}
 
-	if len(d.Id()) > 0 {
-		// This is update flow
-		obj.Revision = &revision
-	}
-
-	policyChildren, err := getUpdatedRuleChildren(d)
-	if err != nil {
-		return err
-	}
-	if len(policyChildren) > 0 {
-		obj.Children = policyChildren
-	}
-
-	return gatewayPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
-}
-
-func resourceNsxtPolicyGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGatewayPolicyExistsPartial(d.Get("domain").(string)))
-	if err != nil {
-		return err
-	}
-
-	err = policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
-	if err != nil {
-		return handleCreateError("Gateway Policy", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return obj
 }
 
-func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func parentGatewayPolicyModelToSchema(d *schema.ResourceData, m interface{}) (*model.GatewayPolicy, error) {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return nil, fmt.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	obj, err := getGatewayPolicyInDomain(getSessionContext(d, m), id, d.Get("domain").(string), connector)
 	if err != nil {
-		return handleReadError(d, "Gateway Policy", id, err)
+		return nil, handleReadError(d, "Gateway Policy", id, err)
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -218,23 +182,94 @@ func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{})
 		d.Set("tcp_strict", *obj.TcpStrict)
 	}
 	d.Set("revision", obj.Revision)
-	return setPolicyRulesInSchema(d, obj.Rules)
+	return &obj, nil
+}
+
+func getPolicyGatewayPolicyLockPath(context utl.SessionContext, domain string, id string) string {
+	return fmt.Sprintf("%s/domains/%s/gateway-policies/%s", getPolicyInfraPath(context), domain, id)
+}
+
+func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, id string, createFlow, withRule bool) error {
+	domain := d.Get("domain").(string)
+	revision := int64(d.Get("revision").(int))
+	obj := parentGatewayPolicySchemaToModel(d, id)
+
+	if !createFlow {
+		// This is update flow
+		obj.Revision = &revision
+	}
+
+	if withRule {
+		policyChildren, err := getUpdatedRuleChildren(d)
+		if err != nil {
+			return err
+		}
+		if len(policyChildren) > 0 {
+			obj.Children = policyChildren
+		}
+	}
+
+	// Rules of this policy might be modified concurrently by rule resources
+	policyPath := getPolicyGatewayPolicyLockPath(getSessionContext(d, m), domain, id)
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
+	return gatewayPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
+}
+
+func resourceNsxtPolicyGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGatewayPolicyGeneralCreate(d, m, true)
+}
+
+func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGatewayPolicyGeneralRead(d, m, true)
 }
 
 func resourceNsxtPolicyGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
+	return resourceNsxtPolicyGatewayPolicyGeneralUpdate(d, m, true)
+}
 
+func resourceNsxtPolicyGatewayPolicyGeneralCreate(d *schema.ResourceData, m interface{}, withRule bool) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGatewayPolicyExistsPartial(d.Get("domain").(string)))
+	if err != nil {
+		return err
+	}
+
+	err = policyGatewayPolicyBuildAndPatch(d, m, id, true, withRule)
+	if err != nil {
+		return handleCreateError("Gateway Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyGatewayPolicyGeneralRead(d, m, withRule)
+}
+
+func resourceNsxtPolicyGatewayPolicyGeneralRead(d *schema.ResourceData, m interface{}, withRule bool) error {
+	obj, err := parentGatewayPolicyModelToSchema(d, m)
+	if err != nil {
+		return err
+	}
+	if withRule {
+		return setPolicyRulesInSchema(d, obj.Rules)
+	}
+	return nil
+}
+
+func resourceNsxtPolicyGatewayPolicyGeneralUpdate(d *schema.ResourceData, m interface{}, withRule bool) error {
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining Gateway Policy ID")
 	}
 
-	err := policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
+	err := policyGatewayPolicyBuildAndPatch(d, m, id, false, withRule)
 	if err != nil {
 		return handleUpdateError("Gateway Policy", id, err)
 	}
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyGeneralRead(d, m, withRule)
 }
 
 func resourceNsxtPolicyGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
@@ -243,9 +278,14 @@ func resourceNsxtPolicyGatewayPolicyDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error obtaining Gateway Policy ID")
 	}
 
+	domain := d.Get("domain").(string)
+	policyPath := getPolicyGatewayPolicyLockPath(getSessionContext(d, m), domain, id)
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
 	connector := getPolicyConnector(m)
 	client := domains.NewGatewayPoliciesClient(getSessionContext(d, m), connector)
-	err := client.Delete(d.Get("domain").(string), id)
+	err := client.Delete(domain, id)
 	if err != nil {
 		return handleDeleteError("Gateway Policy", id, err)
 	}
@@ -0,0 +1,191 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+
+	gatewaypolicies "github.com/vmware/terraform-provider-nsxt/api/infra/domains/gateway_policies"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyGatewayPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGatewayPolicyRuleCreate,
+		Read:   resourceNsxtPolicyGatewayPolicyRuleRead,
+		Update: resourceNsxtPolicyGatewayPolicyRuleUpdate,
+		Delete: resourceNsxtPolicyGatewayPolicyRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtGatewayPolicyRuleImporter,
+		},
+		Schema: getPolicyGatewayPolicyRuleSchema(),
+	}
+}
+
+func getPolicyGatewayPolicyRuleSchema() map[string]*schema.Schema {
+	// GW Policy rules require scope to be set
+	ruleSchema := getSecurityPolicyAndGatewayRuleSchema(true, false, true, true)
+	ruleSchema["policy_path"] = getPolicyPathSchema(true, true, "Gateway Policy path")
+	return ruleSchema
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	policyPath := d.Get("policy_path").(string)
+	projectID := getProjectIDFromResourcePath(policyPath)
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getPolicyIDFromPath(policyPath)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGatewayPolicyRuleExistsPartial(policyPath))
+	if err != nil {
+		return err
+	}
+
+	if err := setSecurityPolicyRuleContext(d, projectID); err != nil {
+		return handleCreateError("GatewayPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
+
+	log.Printf("[INFO] Creating Gateway Policy Rule with ID %s under policy %s", id, policyPath)
+	// Rules of the same policy are serialized, since NSX updates the parent policy
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	rule := securityPolicyRuleSchemaToModel(d, id)
+	err = client.Patch(domain, policyID, id, rule)
+	if err != nil {
+		return handleCreateError("GatewayPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyGatewayPolicyRuleRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleExistsPartial(policyPath string) func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+		return resourceNsxtPolicyGatewayPolicyRuleExists(sessionContext, id, policyPath, connector)
+	}
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleExists(sessionContext utl.SessionContext, id string, policyPath string, connector client.Connector) (bool, error) {
+	client := gatewaypolicies.NewRulesClient(sessionContext, connector)
+
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getPolicyIDFromPath(policyPath)
+	_, err := client.Get(domain, policyID, id)
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Gateway Policy Rule", err)
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Gateway Policy Rule ID")
+	}
+
+	policyPath := d.Get("policy_path").(string)
+	projectID := getProjectIDFromResourcePath(policyPath)
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getPolicyIDFromPath(policyPath)
+
+	if err := setSecurityPolicyRuleContext(d, projectID); err != nil {
+		return handleReadError(d, "GatewayPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
+
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	rule, err := client.Get(domain, policyID, id)
+	if err != nil {
+		return handleReadError(d, "GatewayPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
+
+	securityPolicyRuleModelToSchema(d, rule)
+	return nil
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Gateway Policy Rule ID")
+	}
+
+	policyPath := d.Get("policy_path").(string)
+	log.Printf("[INFO] Updating Gateway Policy Rule with ID %s under policy %s", id, policyPath)
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getPolicyIDFromPath(policyPath)
+
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	rule := securityPolicyRuleSchemaToModel(d, id)
+	// Rules of the same policy may be owned by different configurations, hence
+	// revision is sent in order to fail rather than overwrite concurrent changes
+	revision := int64(d.Get("revision").(int))
+	rule.Revision = &revision
+	err := client.Patch(domain, policyID, id, rule)
+	if err != nil {
+		return handleUpdateError("GatewayPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
+
+	return resourceNsxtPolicyGatewayPolicyRuleRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Gateway Policy Rule ID")
+	}
+
+	connector := getPolicyConnector(m)
+
+	policyPath := d.Get("policy_path").(string)
+	log.Printf("[INFO] Deleting Gateway Policy Rule with ID %s under policy %s", id, policyPath)
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getPolicyIDFromPath(policyPath)
+
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	err := client.Delete(domain, policyID, id)
+	if err != nil {
+		return handleDeleteError("GatewayPolicyRule", fmt.Sprintf("%s/%s", policyPath, id), err)
+	}
+
+	return nil
+}
+
+func nsxtGatewayPolicyRuleImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return rd, err
+	}
+	ruleIdx := strings.LastIndex(importID, "/rules/")
+	if ruleIdx <= 0 || !strings.Contains(importID[:ruleIdx], "/gateway-policies/") {
+		return nil, fmt.Errorf("invalid path of Gateway Policy Rule to import")
+	}
+	d.Set("policy_path", importID[:ruleIdx])
+	return []*schema.ResourceData{d}, nil
+}
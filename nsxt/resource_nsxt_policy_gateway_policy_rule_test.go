@@ -0,0 +1,231 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyGatewayPolicyRule_basic(t *testing.T) {
+	testAccResourceNsxtPolicyGatewayPolicyRuleBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayPolicyRule_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyGatewayPolicyRuleBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyGatewayPolicyRuleBasic(t *testing.T, withContext bool, preCheck func()) {
+	policyResourceName := "nsxt_policy_parent_gateway_policy.policy1"
+	policyName := getAccTestResourceName()
+
+	ruleResourceName := "nsxt_policy_gateway_policy_rule.test"
+	appendRuleResourceName := "nsxt_policy_gateway_policy_rule.test2"
+
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	appendRuleName := getAccTestResourceName()
+	direction := "IN"
+	updatedDirection := "OUT"
+	proto := "IPV4"
+	updatedProto := "IPV4_IPV6"
+	action := "ALLOW"
+	updatedAction := "DROP"
+	seqNum := "1"
+	updatedSeqNum := "2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			if err := testAccNsxtPolicyParentGatewayPolicyCheckDestroy(state, policyName); err != nil {
+				return err
+			}
+			return testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayPolicyRuleDeps(withContext, policyName) +
+					testAccNsxtPolicyGatewayPolicyRuleTemplate("test", name, action, direction, proto, seqNum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyExists(policyResourceName, defaultDomain),
+
+					testAccNsxtPolicyGatewayPolicyRuleExists(ruleResourceName),
+					resource.TestCheckResourceAttr(ruleResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(ruleResourceName, "action", action),
+					resource.TestCheckResourceAttr(ruleResourceName, "direction", direction),
+					resource.TestCheckResourceAttr(ruleResourceName, "ip_version", proto),
+					resource.TestCheckResourceAttr(ruleResourceName, "sequence_number", seqNum),
+					resource.TestCheckResourceAttr(ruleResourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttrSet(ruleResourceName, "rule_id"),
+					resource.TestCheckResourceAttrSet(ruleResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayPolicyRuleDeps(withContext, policyName) +
+					testAccNsxtPolicyGatewayPolicyRuleTemplate("test", updatedName, updatedAction, updatedDirection, updatedProto, updatedSeqNum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyRuleExists(ruleResourceName),
+					resource.TestCheckResourceAttr(ruleResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(ruleResourceName, "action", updatedAction),
+					resource.TestCheckResourceAttr(ruleResourceName, "direction", updatedDirection),
+					resource.TestCheckResourceAttr(ruleResourceName, "ip_version", updatedProto),
+					resource.TestCheckResourceAttr(ruleResourceName, "sequence_number", updatedSeqNum),
+				),
+			},
+			{
+				// Append another Rule to the same policy
+				Config: testAccNsxtPolicyGatewayPolicyRuleDeps(withContext, policyName) +
+					testAccNsxtPolicyGatewayPolicyRuleTemplate("test", updatedName, updatedAction, updatedDirection, updatedProto, updatedSeqNum) +
+					testAccNsxtPolicyGatewayPolicyRuleTemplate("test2", appendRuleName, action, direction, proto, seqNum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyRuleExists(appendRuleResourceName),
+					resource.TestCheckResourceAttr(appendRuleResourceName, "display_name", appendRuleName),
+					resource.TestCheckResourceAttr(appendRuleResourceName, "sequence_number", seqNum),
+
+					testAccNsxtPolicyGatewayPolicyRuleExists(ruleResourceName),
+					resource.TestCheckResourceAttr(ruleResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(ruleResourceName, "sequence_number", updatedSeqNum),
+				),
+			},
+			{
+				// Delete one of the Rules
+				Config: testAccNsxtPolicyGatewayPolicyRuleDeps(withContext, policyName) +
+					testAccNsxtPolicyGatewayPolicyRuleTemplate("test", updatedName, updatedAction, updatedDirection, updatedProto, updatedSeqNum),
+				Check: resource.ComposeTestCheckFunc(
+					func(state *terraform.State) error {
+						return testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state, appendRuleName)
+					},
+					testAccNsxtPolicyGatewayPolicyRuleExists(ruleResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayPolicyRule_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_policy_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayPolicyRuleDeps(false, getAccTestResourceName()) +
+					testAccNsxtPolicyGatewayPolicyRuleTemplate("test", name, "ALLOW", "IN", "IPV4", "1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy GatewayPolicyRule resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy GatewayPolicyRule resource ID not set in resources")
+		}
+
+		policyPath := rs.Primary.Attributes["policy_path"]
+		exists, err := resourceNsxtPolicyGatewayPolicyRuleExists(testAccGetSessionContext(), resourceID, policyPath, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Error while retrieving policy GatewayPolicyRule ID %s under GatewayPolicy %s", resourceID, policyPath)
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_gateway_policy_rule" {
+			continue
+		}
+
+		if rs.Primary.Attributes["display_name"] != displayName {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		policyPath := rs.Primary.Attributes["policy_path"]
+		exists, err := resourceNsxtPolicyGatewayPolicyRuleExists(testAccGetSessionContext(), resourceID, policyPath, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy GatewayPolicyRule %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleDeps(withContext bool, displayName string) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "gwt1test" {
+%s
+  display_name = "tf-t1-gw"
+  description  = "Acceptance Test"
+}
+
+resource "nsxt_policy_parent_gateway_policy" "policy1" {
+%s
+  display_name    = "%s"
+  description     = "Acceptance Test"
+  category        = "LocalGatewayRules"
+  sequence_number = 3
+  stateful        = true
+  tcp_strict      = false
+}`, context, context, displayName)
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleTemplate(resourceName, displayName, action, direction, ipVersion, seqNum string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_gateway_policy_rule" "%s" {
+  display_name    = "%s"
+  policy_path     = nsxt_policy_parent_gateway_policy.policy1.path
+  action          = "%s"
+  direction       = "%s"
+  ip_version      = "%s"
+  sequence_number = %s
+  scope           = [nsxt_policy_tier1_gateway.gwt1test.path]
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, resourceName, displayName, action, direction, ipVersion, seqNum)
+}
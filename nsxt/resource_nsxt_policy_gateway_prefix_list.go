@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -327,6 +328,18 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 
 func resourceNsxtPolicyTier0GatewayImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err == nil {
+		gwPath, err := getAncestorPathFromResourcePath(importID, "tier-0s")
+		if err != nil {
+			return nil, err
+		}
+		d.Set("gateway_path", gwPath)
+		return rd, nil
+	} else if !errors.Is(err, ErrNotAPolicyPath) {
+		return rd, err
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
 		return nil, fmt.Errorf("Please provide <gateway-id>/<id> as an input")
@@ -89,7 +89,11 @@ func policyGatewayRedistributionConfigPatch(d *schema.ResourceData, m interface{
 		return client.Patch(gwID, localeServiceID, serviceStruct)
 	}
 	// since redistribution config is not a separate API endpoint, but sub-clause of Tier0,
-	// concurrency issues may arise that require retry from client side.
+	// concurrent modifications of the locale service are serialized
+	lockPath := getPolicyTier0LocaleServiceLockPath(getSessionContext(d, m), gwID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	return doPatch()
 }
 
@@ -216,6 +220,10 @@ func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData,
 
 	}
 
+	lockPath := getPolicyTier0LocaleServiceLockPath(getSessionContext(d, m), gwID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
@@ -227,13 +235,23 @@ func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData,
 
 func resourceNsxtPolicyGatewayRedistributionConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
-	s := strings.Split(importID, "/")
-	if len(s) != 2 {
-		return nil, fmt.Errorf("Please provide <tier0-gateway-id>/<locale-service-id> as an input")
+	var gwID, localeServiceID string
+	if isPolicyPath(importID) {
+		// Locale service path, such as /infra/tier-0s/gw1/locale-services/default
+		var isT0 bool
+		var err error
+		isT0, gwID, localeServiceID, err = parseLocaleServicePolicyPath(importID)
+		if err != nil || !isT0 {
+			return nil, fmt.Errorf("Tier0 Gateway Locale Service path expected, got %s", importID)
+		}
+	} else {
+		s := strings.Split(importID, "/")
+		if len(s) != 2 {
+			return nil, fmt.Errorf("Please provide <tier0-gateway-id>/<locale-service-id> or locale service policy path as an input")
+		}
+		gwID = s[0]
+		localeServiceID = s[1]
 	}
-
-	gwID := s[0]
-	localeServiceID := s[1]
 	connector := getPolicyConnector(m)
 	client := tier0s.NewLocaleServicesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(gwID, localeServiceID)
@@ -0,0 +1,208 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyGeneric() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGenericCreate,
+		Read:   resourceNsxtPolicyGenericRead,
+		Update: resourceNsxtPolicyGenericUpdate,
+		Delete: resourceNsxtPolicyGenericDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyGenericImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"context": getContextSchema(),
+			"body": {
+				Type:         schema.TypeString,
+				Description:  "JSON body of the object. Only keys specified here are managed",
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return isPolicyGenericJSONEqual(old, new)
+				},
+			},
+			"ignore_fields": {
+				Type:        schema.TypeList,
+				Description: "Fields in dot notation to ignore when detecting drift",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"revision": getRevisionSchema(),
+		},
+	}
+}
+
+func getPolicyGenericBodyFromSchema(d *schema.ResourceData) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	bodyStr := d.Get("body").(string)
+	if bodyStr == "" {
+		return body, nil
+	}
+	if err := decodePolicyGenericJSON([]byte(bodyStr), &body); err != nil {
+		return nil, fmt.Errorf("Failed to parse body: %v", err)
+	}
+	return body, nil
+}
+
+func resourceNsxtPolicyGenericExists(context utl.SessionContext, path string, connector client.Connector) (bool, error) {
+	_, err := policyGenericGet(connector, context, path)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Policy Object", err)
+}
+
+func resourceNsxtPolicyGenericCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	path := d.Get("path").(string)
+
+	exists, err := resourceNsxtPolicyGenericExists(context, path, connector)
+	if err != nil {
+		return handleCreateError("Policy Object", path, err)
+	}
+	if exists {
+		return fmt.Errorf("Object with path %s already exists", path)
+	}
+
+	body, err := getPolicyGenericBodyFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Policy Object with path %s", path)
+	err = policyGenericPatch(connector, context, path, body)
+	if err != nil {
+		return handleCreateError("Policy Object", path, err)
+	}
+
+	d.SetId(path)
+	return resourceNsxtPolicyGenericRead(d, m)
+}
+
+func resourceNsxtPolicyGenericRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	path := d.Id()
+	if path == "" {
+		return fmt.Errorf("Error obtaining Policy Object path")
+	}
+
+	obj, err := policyGenericGet(connector, getSessionContext(d, m), path)
+	if err != nil {
+		return handleReadError(d, "Policy Object", path, err)
+	}
+
+	intended, err := getPolicyGenericBodyFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	var observed map[string]interface{}
+	if len(intended) == 0 {
+		// No user intent known (import), assume all configurable fields are managed
+		observed = stripPolicyGenericSystemFields(obj)
+	} else {
+		observed = projectPolicyGenericObject(intended, obj).(map[string]interface{})
+	}
+	applyPolicyGenericIgnoreFields(intended, observed, interface2StringList(d.Get("ignore_fields").([]interface{})))
+
+	bodyBytes, err := json.Marshal(observed)
+	if err != nil {
+		return err
+	}
+
+	d.Set("path", path)
+	d.Set("body", string(bodyBytes))
+	if revision, ok := obj["_revision"].(json.Number); ok {
+		revisionValue, err := revision.Int64()
+		if err != nil {
+			return err
+		}
+		d.Set("revision", int(revisionValue))
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyGenericUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	path := d.Id()
+
+	body, err := getPolicyGenericBodyFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Policy Object with path %s", path)
+	err = policyGenericPatch(connector, getSessionContext(d, m), path, body)
+	if err != nil {
+		return handleUpdateError("Policy Object", path, err)
+	}
+
+	return resourceNsxtPolicyGenericRead(d, m)
+}
+
+func resourceNsxtPolicyGenericDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	path := d.Id()
+
+	log.Printf("[INFO] Deleting Policy Object with path %s", path)
+	err := policyGenericDelete(connector, getSessionContext(d, m), path)
+	if err != nil {
+		return handleDeleteError("Policy Object", path, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyGenericImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if !isPolicyPath(importID) {
+		return nil, fmt.Errorf("Policy path expected, got %s", importID)
+	}
+
+	path := importID
+	if strings.HasPrefix(importID, "/orgs/") {
+		// Project infra objects are configured with path relative to the project
+		projectID := getProjectIDFromResourcePath(importID)
+		prefix := fmt.Sprintf("/orgs/%s/projects/%s", getResourceIDFromResourcePath(importID, "orgs"), projectID)
+		if strings.HasPrefix(importID, prefix+"/infra/") {
+			path = strings.TrimPrefix(importID, prefix)
+			d.Set("context", []interface{}{map[string]interface{}{"project_id": projectID}})
+		}
+	}
+
+	d.SetId(path)
+	d.Set("path", path)
+	return []*schema.ResourceData{d}, nil
+}
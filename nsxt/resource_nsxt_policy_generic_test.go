@@ -0,0 +1,122 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyGeneric_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_generic.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(testAccGetSessionContext(), state, name, "nsxt_policy_generic", resourceNsxtPolicyGenericExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGenericTemplate(name, name, "1.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testAccGetSessionContext(), testResourceName, resourceNsxtPolicyGenericExists),
+					resource.TestCheckResourceAttr(testResourceName, "path", fmt.Sprintf("/infra/domains/default/groups/%s", name)),
+					testAccNsxtPolicyGenericCheckBody(testResourceName, "display_name", name),
+					testAccNsxtPolicyGenericCheckBody(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "ignore_fields.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ignore_fields.0", "description"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGenericTemplate(name, updatedName, "2.2.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testAccGetSessionContext(), testResourceName, resourceNsxtPolicyGenericExists),
+					resource.TestCheckResourceAttr(testResourceName, "path", fmt.Sprintf("/infra/domains/default/groups/%s", name)),
+					testAccNsxtPolicyGenericCheckBody(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				// Change of ignored field outside of terraform should not be detected as drift
+				PreConfig: func() {
+					connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+					path := fmt.Sprintf("/infra/domains/default/groups/%s", name)
+					err := policyGenericPatch(connector, testAccGetSessionContext(), path, map[string]interface{}{"description": "Changed"})
+					if err != nil {
+						t.Fatalf("Failed to update %s: %v", path, err)
+					}
+				},
+				Config:   testAccNsxtPolicyGenericTemplate(name, updatedName, "2.2.2.2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGeneric_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_generic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(testAccGetSessionContext(), state, name, "nsxt_policy_generic", resourceNsxtPolicyGenericExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGenericTemplate(name, name, "1.1.1.1"),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGenericCheckBody(resourceName string, key string, expected string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(resourceName, "body", func(value string) error {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &body); err != nil {
+			return err
+		}
+		if body[key] != expected {
+			return fmt.Errorf("expected %s to be %s in body, got %v", key, expected, body[key])
+		}
+		return nil
+	})
+}
+
+func testAccNsxtPolicyGenericTemplate(id string, displayName string, address string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_generic" "test" {
+  path = "/infra/domains/default/groups/%s"
+  body = jsonencode({
+    display_name = "%s"
+    description  = "Acceptance Test"
+    expression = [{
+      resource_type = "IPAddressExpression"
+      ip_addresses  = ["%s"]
+    }]
+  })
+  ignore_fields = ["description"]
+}`, id, displayName, address)
+}
@@ -1017,12 +1017,7 @@ func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error
 		return client.Delete(d.Get("domain").(string), id, &failIfSubtreeExists, &forceDelete)
 	}
 
-	err := doDelete()
-	if err != nil {
-		return handleDeleteError("Group", id, err)
-	}
-
-	return nil
+	return policyDeleteWithReferrersCheck(d, m, "Group", doDelete)
 }
 
 func buildGroupExtendedExpressionListData(extendedCriteriaSets []interface{}) ([]*data.StructValue, error) {
@@ -0,0 +1,185 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIgmpProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIgmpProfileCreate,
+		Read:   resourceNsxtPolicyIgmpProfileRead,
+		Update: resourceNsxtPolicyIgmpProfileUpdate,
+		Delete: resourceNsxtPolicyIgmpProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"query_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval in seconds between general IGMP host-query messages",
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 1800),
+			},
+			"query_max_response_time": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum time in seconds that can elapse between host-query message and response from host",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 25),
+			},
+			"last_member_query_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Max response time in seconds for Group-Specific Queries sent in response to Leave Group messages",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 25),
+			},
+			"robustness_variable": {
+				Type:         schema.TypeInt,
+				Description:  "Tuning for the expected packet loss on a subnet",
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIgmpProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewIgmpProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyIgmpProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	queryInterval := int64(d.Get("query_interval").(int))
+	queryMaxResponseTime := int64(d.Get("query_max_response_time").(int))
+	lastMemberQueryInterval := int64(d.Get("last_member_query_interval").(int))
+	robustnessVariable := int64(d.Get("robustness_variable").(int))
+
+	obj := model.PolicyIgmpProfile{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		QueryInterval:           &queryInterval,
+		QueryMaxResponseTime:    &queryMaxResponseTime,
+		LastMemberQueryInterval: &lastMemberQueryInterval,
+		RobustnessVariable:      &robustnessVariable,
+	}
+
+	log.Printf("[INFO] Patching IgmpProfile with ID %s", id)
+	client := infra.NewIgmpProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyIgmpProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIgmpProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyIgmpProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("IgmpProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIgmpProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIgmpProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IgmpProfile ID")
+	}
+
+	client := infra.NewIgmpProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "IgmpProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("query_interval", obj.QueryInterval)
+	d.Set("query_max_response_time", obj.QueryMaxResponseTime)
+	d.Set("last_member_query_interval", obj.LastMemberQueryInterval)
+	d.Set("robustness_variable", obj.RobustnessVariable)
+
+	return nil
+}
+
+func resourceNsxtPolicyIgmpProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IgmpProfile ID")
+	}
+
+	err := resourceNsxtPolicyIgmpProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("IgmpProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIgmpProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIgmpProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IgmpProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewIgmpProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("IgmpProfile", id, err)
+	}
+
+	return nil
+}
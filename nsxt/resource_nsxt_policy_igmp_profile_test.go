@@ -0,0 +1,161 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyIgmpProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_igmp_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIgmpProfileCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIgmpProfileCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIgmpProfileExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "query_interval", "30"),
+					resource.TestCheckResourceAttr(testResourceName, "query_max_response_time", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "last_member_query_interval", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "robustness_variable", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIgmpProfileUpdateTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIgmpProfileExists(updatedName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "query_interval", "60"),
+					resource.TestCheckResourceAttr(testResourceName, "query_max_response_time", "20"),
+					resource.TestCheckResourceAttr(testResourceName, "last_member_query_interval", "5"),
+					resource.TestCheckResourceAttr(testResourceName, "robustness_variable", "3"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIgmpProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_igmp_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIgmpProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIgmpProfileCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIgmpProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IgmpProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IgmpProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIgmpProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy IgmpProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIgmpProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_igmp_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIgmpProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy IgmpProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIgmpProfileCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+  description  = "test"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyIgmpProfileUpdateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+  description  = "test"
+  query_interval             = 60
+  query_max_response_time    = 20
+  last_member_query_interval = 5
+  robustness_variable        = 3
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
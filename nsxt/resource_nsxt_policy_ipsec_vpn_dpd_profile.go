@@ -26,7 +26,7 @@ func resourceNsxtPolicyIPSecVpnDpdProfile() *schema.Resource {
 		Update: resourceNsxtPolicyIPSecVpnDpdProfileUpdate,
 		Delete: resourceNsxtPolicyIPSecVpnDpdProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,7 +53,7 @@ func resourceNsxtPolicyIPSecVpnIkeProfile() *schema.Resource {
 		Update: resourceNsxtPolicyIPSecVpnIkeProfileUpdate,
 		Delete: resourceNsxtPolicyIPSecVpnIkeProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
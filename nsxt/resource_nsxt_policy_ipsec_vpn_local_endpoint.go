@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -336,19 +337,19 @@ func nsxtVPNServiceResourceImporter(d *schema.ResourceData, m interface{}) ([]*s
 	importID := d.Id()
 	err := fmt.Errorf("VPN Local Endpoint Path expected, got %s", importID)
 	// path format example: /infra/tier-1s/aaa/locale-services/default/ipsec-vpn-services/bbb/local-endpoints/ccc
-	s := strings.Split(importID, "/")
-	if len(s) < 8 {
-		return []*schema.ResourceData{d}, err
+	rd, pathErr := nsxtPolicyPathResourceImporterHelper(d, m)
+	if errors.Is(pathErr, ErrNotAPolicyPath) {
+		return rd, err
+	} else if pathErr != nil {
+		return rd, pathErr
 	}
 
-	endpointID := s[len(s)-1]
-	d.SetId(endpointID)
-	s = strings.Split(importID, "/local-endpoints/")
+	s := strings.Split(importID, "/local-endpoints/")
 	if len(s) != 2 {
-		return []*schema.ResourceData{d}, err
+		return rd, err
 	}
 
 	d.Set("service_path", s[0])
 
-	return []*schema.ResourceData{d}, nil
+	return rd, nil
 }
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -135,20 +136,20 @@ func updateNsxtPolicyIPSecVpnService(connector client.Connector, gwID string, lo
 
 func resourceNsxtPolicyIPSecVpnServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
-	s := strings.Split(importID, "/")
 	err := fmt.Errorf("Expected policy path for the IPSec VPN Service, got %s", importID)
 	// The policy path of IPSec VPN Service should be like /infra/tier-0s/aaa/locale-services/bbb/ipsec-vpn-services/ccc
 	// or /infra/tier-0s/aaa/ipsec-vpn-services/bbb
-	if len(s) != 8 && len(s) != 6 {
-		return nil, err
+	rd, pathErr := nsxtPolicyPathResourceImporterHelper(d, m)
+	if errors.Is(pathErr, ErrNotAPolicyPath) {
+		return rd, err
+	} else if pathErr != nil {
+		return rd, pathErr
 	}
-	useLocaleService := len(s) == 8
-	d.SetId(s[len(s)-1])
-	s = strings.Split(importID, "/ipsec-vpn-services/")
+	s := strings.Split(importID, "/ipsec-vpn-services/")
 	if len(s) != 2 {
-		return []*schema.ResourceData{d}, err
+		return rd, err
 	}
-	if useLocaleService {
+	if strings.Contains(s[0], "/locale-services/") {
 		d.Set("locale_service_path", s[0])
 	} else {
 		d.Set("gateway_path", s[0])
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -116,11 +117,13 @@ func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 				Default:     true,
 			},
 			"psk": {
-				Type:        schema.TypeString,
-				Description: "IPSec Pre-shared key. Maximum length of this field is 128 characters.",
-				Optional:    true,
-				Sensitive:   true,
+				Type:             schema.TypeString,
+				Description:      "IPSec Pre-shared key. Maximum length of this field is 128 characters.",
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: secretDiffSuppressFunc,
 			},
+			"psk_version": getSecretVersionSchema("psk"),
 			"peer_id": {
 				Type:         schema.TypeString,
 				Description:  "Peer ID to uniquely identify the peer site. The peer ID is the public IP address of the remote device terminating the VPN tunnel. When NAT is configured for the peer, enter the private IP address of the peer.",
@@ -169,7 +172,7 @@ func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
-	psk := d.Get("psk").(string)
+	psk := getSecretFromConfig(d, "psk")
 	peerID := d.Get("peer_id").(string)
 	peerAddress := d.Get("peer_address").(string)
 	displayName := d.Get("display_name").(string)
@@ -693,6 +696,8 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 			}
 		}
 	}
+	// psk is not returned by NSX, only its hash is kept in state
+	setSecretHashInSchema(d, "psk")
 	return nil
 }
 
@@ -749,18 +754,18 @@ func nsxtVpnSessionImporter(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	err := fmt.Errorf("Expected VPN session path, got %s", importID)
 	// Path should be like /infra/tier-1s/aaa/locale-services/default/ipsec-vpn-services/bbb/sessions/ccc
 	// or /infra/tier-0s/vmc/ipsec-vpn-services/default/sessions/ccc for VMC
-	s := strings.Split(importID, "/")
-	if len(s) < 8 {
-		return []*schema.ResourceData{d}, err
+	rd, pathErr := nsxtPolicyPathResourceImporterHelper(d, m)
+	if errors.Is(pathErr, ErrNotAPolicyPath) {
+		return rd, err
+	} else if pathErr != nil {
+		return rd, pathErr
 	}
 
-	d.SetId(s[len(s)-1])
-
-	s = strings.Split(importID, "/sessions/")
+	s := strings.Split(importID, "/sessions/")
 	if len(s) != 2 {
-		return []*schema.ResourceData{d}, err
+		return rd, err
 	}
 	d.Set("service_path", s[0])
 
-	return []*schema.ResourceData{d}, nil
+	return rd, nil
 }
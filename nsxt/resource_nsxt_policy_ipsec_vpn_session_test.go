@@ -134,7 +134,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionRouteBased_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "prefix_length", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["prefix_length"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["connection_initiation_mode"]),
 
 					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
@@ -161,7 +161,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionRouteBased_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "prefix_length", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["prefix_length"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["connection_initiation_mode"]),
 
 					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
@@ -188,7 +188,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionRouteBased_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "prefix_length", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["prefix_length"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", "INITIATOR"),
 
 					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
@@ -298,7 +298,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionPolicyBased_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "compliance_suite", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["compliance_suite"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["connection_initiation_mode"]),
 					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
 					resource.TestCheckResourceAttr(testResourceName, "rule.0.sources.0", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["sources"]),
@@ -327,7 +327,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionPolicyBased_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "compliance_suite", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["compliance_suite"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["connection_initiation_mode"]),
 					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
 					resource.TestCheckResourceAttr(testResourceName, "rule.0.sources.0", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["sources"]),
@@ -419,7 +419,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionPolicyBased_tier1(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "compliance_suite", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["compliance_suite"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["connection_initiation_mode"]),
 					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
 					resource.TestCheckResourceAttr(testResourceName, "rule.0.sources.0", accTestPolicyIPSecVpnSessionPolicyBasedCreateAttributes["sources"]),
@@ -448,7 +448,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionPolicyBased_tier1(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "compliance_suite", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["compliance_suite"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["connection_initiation_mode"]),
 					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
 					resource.TestCheckResourceAttr(testResourceName, "rule.0.sources.0", accTestPolicyIPSecVpnSessionPolicyBasedUpdateAttributes["sources"]),
@@ -495,7 +495,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionRouteBased_tier1(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "prefix_length", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["prefix_length"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes["connection_initiation_mode"]),
 
 					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
@@ -522,7 +522,7 @@ func TestAccResourceNsxtPolicyIPSecVpnSessionRouteBased_tier1(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "prefix_length", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["prefix_length"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_address", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["peer_address"]),
 					resource.TestCheckResourceAttr(testResourceName, "peer_id", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["peer_id"]),
-					resource.TestCheckResourceAttr(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["psk"]),
+					testAccCheckResourceSecretHash(testResourceName, "psk", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["psk"]),
 					resource.TestCheckResourceAttr(testResourceName, "connection_initiation_mode", accTestPolicyIPSecVpnSessionRouteBasedUpdateAttributes["connection_initiation_mode"]),
 
 					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
@@ -56,7 +56,7 @@ func resourceNsxtPolicyIPSecVpnTunnelProfile() *schema.Resource {
 		Update: resourceNsxtPolicyIPSecVpnTunnelProfileUpdate,
 		Delete: resourceNsxtPolicyIPSecVpnTunnelProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
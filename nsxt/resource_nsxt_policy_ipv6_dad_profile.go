@@ -0,0 +1,181 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var ipv6DadProfileDadModeValues = []string{
+	model.Ipv6DadProfile_DAD_MODE_LOOSE,
+	model.Ipv6DadProfile_DAD_MODE_STRICT,
+}
+
+func resourceNsxtPolicyIpv6DadProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpv6DadProfileCreate,
+		Read:   resourceNsxtPolicyIpv6DadProfileRead,
+		Update: resourceNsxtPolicyIpv6DadProfileUpdate,
+		Delete: resourceNsxtPolicyIpv6DadProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(),
+			"dad_mode": {
+				Type:         schema.TypeString,
+				Description:  "DAD Mode",
+				Optional:     true,
+				Default:      model.Ipv6DadProfile_DAD_MODE_LOOSE,
+				ValidateFunc: validation.StringInSlice(ipv6DadProfileDadModeValues, false),
+			},
+			"ns_retries": {
+				Type:         schema.TypeInt,
+				Description:  "Number of Neighbor solicitation packets generated before completing the Duplicate address detection process",
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"wait_time": {
+				Type:         schema.TypeInt,
+				Description:  "The time duration in seconds, to wait for Neighbor advertisement after sending the Neighbor solicitation message",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpv6DadProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewIpv6DadProfilesClient(sessionContext, connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyIpv6DadProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	dadMode := d.Get("dad_mode").(string)
+	nsRetries := int64(d.Get("ns_retries").(int))
+	waitTime := int64(d.Get("wait_time").(int))
+
+	obj := model.Ipv6DadProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		DadMode:     &dadMode,
+		NsRetries:   &nsRetries,
+		WaitTime:    &waitTime,
+	}
+
+	log.Printf("[INFO] Patching Ipv6DadProfile with ID %s", id)
+	client := infra.NewIpv6DadProfilesClient(getSessionContext(d, m), connector)
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyIpv6DadProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIpv6DadProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyIpv6DadProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Ipv6DadProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpv6DadProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpv6DadProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6DadProfile ID")
+	}
+
+	client := infra.NewIpv6DadProfilesClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Ipv6DadProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("dad_mode", obj.DadMode)
+	d.Set("ns_retries", obj.NsRetries)
+	d.Set("wait_time", obj.WaitTime)
+
+	return nil
+}
+
+func resourceNsxtPolicyIpv6DadProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6DadProfile ID")
+	}
+
+	err := resourceNsxtPolicyIpv6DadProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Ipv6DadProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIpv6DadProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpv6DadProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6DadProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewIpv6DadProfilesClient(getSessionContext(d, m), connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Ipv6DadProfile", id, err)
+	}
+
+	return nil
+}
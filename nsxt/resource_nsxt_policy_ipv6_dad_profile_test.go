@@ -0,0 +1,235 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpv6DadProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"dad_mode":     "STRICT",
+	"ns_retries":   "5",
+	"wait_time":    "2",
+}
+
+var accTestPolicyIpv6DadProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"dad_mode":     "LOOSE",
+	"ns_retries":   "7",
+	"wait_time":    "10",
+}
+
+func TestAccResourceNsxtPolicyIpv6DadProfile_basic(t *testing.T) {
+	testAccResourceNsxtPolicyIpv6DadProfileBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6DadProfile_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyIpv6DadProfileBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyIpv6DadProfileBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_ipv6_dad_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6DadProfileCheckDestroy(state, accTestPolicyIpv6DadProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6DadProfileTemplate(true, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6DadProfileExists(accTestPolicyIpv6DadProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpv6DadProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpv6DadProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "dad_mode", accTestPolicyIpv6DadProfileCreateAttributes["dad_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "ns_retries", accTestPolicyIpv6DadProfileCreateAttributes["ns_retries"]),
+					resource.TestCheckResourceAttr(testResourceName, "wait_time", accTestPolicyIpv6DadProfileCreateAttributes["wait_time"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpv6DadProfileTemplate(false, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6DadProfileExists(accTestPolicyIpv6DadProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpv6DadProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpv6DadProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "dad_mode", accTestPolicyIpv6DadProfileUpdateAttributes["dad_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "ns_retries", accTestPolicyIpv6DadProfileUpdateAttributes["ns_retries"]),
+					resource.TestCheckResourceAttr(testResourceName, "wait_time", accTestPolicyIpv6DadProfileUpdateAttributes["wait_time"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpv6DadProfileMinimalistic(withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6DadProfileExists(accTestPolicyIpv6DadProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "dad_mode", "LOOSE"),
+					resource.TestCheckResourceAttr(testResourceName, "ns_retries", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "wait_time", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6DadProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipv6_dad_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6DadProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6DadProfileMinimalistic(false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6DadProfile_importBasic_multitenancy(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipv6_dad_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyMultitenancy(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6DadProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6DadProfileMinimalistic(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpv6DadProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Ipv6DadProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Ipv6DadProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIpv6DadProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Ipv6DadProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIpv6DadProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipv6_dad_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIpv6DadProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Ipv6DadProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIpv6DadProfileTemplate(createFlow, withContext bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpv6DadProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpv6DadProfileUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipv6_dad_profile" "test" {
+%s
+  display_name = "%s"
+  description  = "%s"
+  dad_mode     = "%s"
+  ns_retries   = %s
+  wait_time    = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["dad_mode"], attrMap["ns_retries"], attrMap["wait_time"])
+}
+
+func testAccNsxtPolicyIpv6DadProfileMinimalistic(withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipv6_dad_profile" "test" {
+%s
+  display_name = "%s"
+}`, context, accTestPolicyIpv6DadProfileUpdateAttributes["display_name"])
+}
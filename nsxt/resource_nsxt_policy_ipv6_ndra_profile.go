@@ -0,0 +1,371 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var ipv6NdraProfileRaModeValues = []string{
+	model.Ipv6NdraProfile_RA_MODE_DISABLED,
+	model.Ipv6NdraProfile_RA_MODE_SLAAC_DNS_THROUGH_RA,
+	model.Ipv6NdraProfile_RA_MODE_SLAAC_DNS_THROUGH_DHCP,
+	model.Ipv6NdraProfile_RA_MODE_DHCP_ADDRESS_AND_DNS_THROUGH_DHCP,
+	model.Ipv6NdraProfile_RA_MODE_SLAAC_AND_ADDRESS_DNS_THROUGH_DHCP,
+}
+
+var ipv6NdraProfileRouterPreferenceValues = []string{
+	model.RAConfig_ROUTER_PREFERENCE_LOW,
+	model.RAConfig_ROUTER_PREFERENCE_MEDIUM,
+	model.RAConfig_ROUTER_PREFERENCE_HIGH,
+}
+
+func resourceNsxtPolicyIpv6NdraProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpv6NdraProfileCreate,
+		Read:   resourceNsxtPolicyIpv6NdraProfileRead,
+		Update: resourceNsxtPolicyIpv6NdraProfileUpdate,
+		Delete: resourceNsxtPolicyIpv6NdraProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(),
+			"ra_mode": {
+				Type:         schema.TypeString,
+				Description:  "RA Mode",
+				Optional:     true,
+				Default:      model.Ipv6NdraProfile_RA_MODE_SLAAC_DNS_THROUGH_RA,
+				ValidateFunc: validation.StringInSlice(ipv6NdraProfileRaModeValues, false),
+			},
+			"reachable_timer": {
+				Type:         schema.TypeInt,
+				Description:  "Neighbour reachable time duration in milliseconds. A value of 0 means unspecified",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600000),
+			},
+			"retransmit_interval": {
+				Type:         schema.TypeInt,
+				Description:  "The time, in milliseconds, between retransmitted neighbour solicitation messages",
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ra_config": {
+				Type:        schema.TypeList,
+				Description: "Router Advertisement configuration",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hop_limit": {
+							Type:         schema.TypeInt,
+							Description:  "The maximum number of hops through which packets can pass before being discarded",
+							Optional:     true,
+							Default:      64,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+						"prefix_lifetime": {
+							Type:         schema.TypeInt,
+							Description:  "The time interval in seconds, in which the prefix is advertised as valid",
+							Optional:     true,
+							Default:      2592000,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"prefix_preferred_time": {
+							Type:         schema.TypeInt,
+							Description:  "The time interval in seconds, in which the prefix is advertised as preferred",
+							Optional:     true,
+							Default:      604800,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"ra_interval": {
+							Type:         schema.TypeInt,
+							Description:  "Interval between 2 Router Advertisement messages in seconds",
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntBetween(4, 1800),
+						},
+						"router_lifetime": {
+							Type:         schema.TypeInt,
+							Description:  "Router lifetime value in seconds",
+							Optional:     true,
+							Default:      1800,
+							ValidateFunc: validation.IntBetween(0, 9000),
+						},
+						"router_preference": {
+							Type:         schema.TypeString,
+							Description:  "Router preference value",
+							Optional:     true,
+							Default:      model.RAConfig_ROUTER_PREFERENCE_MEDIUM,
+							ValidateFunc: validation.StringInSlice(ipv6NdraProfileRouterPreferenceValues, false),
+						},
+					},
+				},
+			},
+			"dns_config": {
+				Type:        schema.TypeList,
+				Description: "DNS configuration advertised in Router Advertisement",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_server": {
+							Type:        schema.TypeList,
+							Description: "DNS servers (RDNSS)",
+							Optional:    true,
+							MaxItems:    8,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPv6Address,
+							},
+						},
+						"dns_server_lifetime": {
+							Type:         schema.TypeInt,
+							Description:  "Lifetime of DNS servers in milliseconds",
+							Optional:     true,
+							Default:      1800000,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"domain_name": {
+							Type:        schema.TypeList,
+							Description: "Domain names in Router Advertisement (DNSSL)",
+							Optional:    true,
+							MaxItems:    8,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"domain_name_lifetime": {
+							Type:         schema.TypeInt,
+							Description:  "Lifetime of domain names in milliseconds",
+							Optional:     true,
+							Default:      1800000,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpv6NdraProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewIpv6NdraProfilesClient(sessionContext, connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyIpv6NdraRAConfigFromSchema(d *schema.ResourceData) *model.RAConfig {
+	configs := d.Get("ra_config").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	data := configs[0].(map[string]interface{})
+	hopLimit := int64(data["hop_limit"].(int))
+	prefixLifetime := int64(data["prefix_lifetime"].(int))
+	prefixPreferredTime := int64(data["prefix_preferred_time"].(int))
+	raInterval := int64(data["ra_interval"].(int))
+	routerLifetime := int64(data["router_lifetime"].(int))
+	routerPreference := data["router_preference"].(string)
+
+	return &model.RAConfig{
+		HopLimit:            &hopLimit,
+		PrefixLifetime:      &prefixLifetime,
+		PrefixPreferredTime: &prefixPreferredTime,
+		RaInterval:          &raInterval,
+		RouterLifetime:      &routerLifetime,
+		RouterPreference:    &routerPreference,
+	}
+}
+
+func setPolicyIpv6NdraRAConfigInSchema(d *schema.ResourceData, config *model.RAConfig) error {
+	var result []interface{}
+	if config != nil {
+		elem := make(map[string]interface{})
+		elem["hop_limit"] = config.HopLimit
+		elem["prefix_lifetime"] = config.PrefixLifetime
+		elem["prefix_preferred_time"] = config.PrefixPreferredTime
+		elem["ra_interval"] = config.RaInterval
+		elem["router_lifetime"] = config.RouterLifetime
+		elem["router_preference"] = config.RouterPreference
+		result = append(result, elem)
+	}
+
+	return d.Set("ra_config", result)
+}
+
+func getPolicyIpv6NdraDNSConfigFromSchema(d *schema.ResourceData) *model.RaDNSConfig {
+	configs := d.Get("dns_config").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	data := configs[0].(map[string]interface{})
+	dnsServerLifetime := int64(data["dns_server_lifetime"].(int))
+	domainNameLifetime := int64(data["domain_name_lifetime"].(int))
+
+	return &model.RaDNSConfig{
+		DnsServer:          interface2StringList(data["dns_server"].([]interface{})),
+		DnsServerLifetime:  &dnsServerLifetime,
+		DomainName:         interface2StringList(data["domain_name"].([]interface{})),
+		DomainNameLifetime: &domainNameLifetime,
+	}
+}
+
+func setPolicyIpv6NdraDNSConfigInSchema(d *schema.ResourceData, config *model.RaDNSConfig) error {
+	var result []interface{}
+	if config != nil {
+		elem := make(map[string]interface{})
+		elem["dns_server"] = config.DnsServer
+		elem["dns_server_lifetime"] = config.DnsServerLifetime
+		elem["domain_name"] = config.DomainName
+		elem["domain_name_lifetime"] = config.DomainNameLifetime
+		result = append(result, elem)
+	}
+
+	return d.Set("dns_config", result)
+}
+
+func resourceNsxtPolicyIpv6NdraProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	raMode := d.Get("ra_mode").(string)
+	reachableTimer := int64(d.Get("reachable_timer").(int))
+	retransmitInterval := int64(d.Get("retransmit_interval").(int))
+
+	obj := model.Ipv6NdraProfile{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		RaMode:             &raMode,
+		ReachableTimer:     &reachableTimer,
+		RetransmitInterval: &retransmitInterval,
+		RaConfig:           getPolicyIpv6NdraRAConfigFromSchema(d),
+		DnsConfig:          getPolicyIpv6NdraDNSConfigFromSchema(d),
+	}
+
+	log.Printf("[INFO] Patching Ipv6NdraProfile with ID %s", id)
+	client := infra.NewIpv6NdraProfilesClient(getSessionContext(d, m), connector)
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIpv6NdraProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyIpv6NdraProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Ipv6NdraProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpv6NdraProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID")
+	}
+
+	client := infra.NewIpv6NdraProfilesClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Ipv6NdraProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ra_mode", obj.RaMode)
+	d.Set("reachable_timer", obj.ReachableTimer)
+	d.Set("retransmit_interval", obj.RetransmitInterval)
+
+	err = setPolicyIpv6NdraRAConfigInSchema(d, obj.RaConfig)
+	if err != nil {
+		return handleReadError(d, "Ipv6NdraProfile", id, err)
+	}
+
+	err = setPolicyIpv6NdraDNSConfigInSchema(d, obj.DnsConfig)
+	if err != nil {
+		return handleReadError(d, "Ipv6NdraProfile", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIpv6NdraProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID")
+	}
+
+	err := resourceNsxtPolicyIpv6NdraProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Ipv6NdraProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIpv6NdraProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewIpv6NdraProfilesClient(getSessionContext(d, m), connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Ipv6NdraProfile", id, err)
+	}
+
+	return nil
+}
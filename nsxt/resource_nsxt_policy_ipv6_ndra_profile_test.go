@@ -0,0 +1,268 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpv6NdraProfileCreateAttributes = map[string]string{
+	"display_name":        getAccTestResourceName(),
+	"description":         "terraform created",
+	"ra_mode":             "SLAAC_DNS_THROUGH_RA",
+	"reachable_timer":     "1000",
+	"retransmit_interval": "2000",
+	"hop_limit":           "32",
+	"ra_interval":         "300",
+	"router_preference":   "HIGH",
+	"dns_server":          "2001::1",
+	"domain_name":         "example.org",
+}
+
+var accTestPolicyIpv6NdraProfileUpdateAttributes = map[string]string{
+	"display_name":        getAccTestResourceName(),
+	"description":         "terraform updated",
+	"ra_mode":             "SLAAC_DNS_THROUGH_DHCP",
+	"reachable_timer":     "5000",
+	"retransmit_interval": "3000",
+	"hop_limit":           "16",
+	"ra_interval":         "120",
+	"router_preference":   "LOW",
+	"dns_server":          "2001::2",
+	"domain_name":         "example.com",
+}
+
+func TestAccResourceNsxtPolicyIpv6NdraProfile_basic(t *testing.T) {
+	testAccResourceNsxtPolicyIpv6NdraProfileBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6NdraProfile_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyIpv6NdraProfileBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyIpv6NdraProfileBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_ipv6_ndra_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state, accTestPolicyIpv6NdraProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileTemplate(true, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6NdraProfileExists(accTestPolicyIpv6NdraProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpv6NdraProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpv6NdraProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_mode", accTestPolicyIpv6NdraProfileCreateAttributes["ra_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "reachable_timer", accTestPolicyIpv6NdraProfileCreateAttributes["reachable_timer"]),
+					resource.TestCheckResourceAttr(testResourceName, "retransmit_interval", accTestPolicyIpv6NdraProfileCreateAttributes["retransmit_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.0.hop_limit", accTestPolicyIpv6NdraProfileCreateAttributes["hop_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.0.ra_interval", accTestPolicyIpv6NdraProfileCreateAttributes["ra_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.0.router_preference", accTestPolicyIpv6NdraProfileCreateAttributes["router_preference"]),
+					resource.TestCheckResourceAttr(testResourceName, "dns_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "dns_config.0.dns_server.0", accTestPolicyIpv6NdraProfileCreateAttributes["dns_server"]),
+					resource.TestCheckResourceAttr(testResourceName, "dns_config.0.domain_name.0", accTestPolicyIpv6NdraProfileCreateAttributes["domain_name"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileTemplate(false, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6NdraProfileExists(accTestPolicyIpv6NdraProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpv6NdraProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpv6NdraProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_mode", accTestPolicyIpv6NdraProfileUpdateAttributes["ra_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "reachable_timer", accTestPolicyIpv6NdraProfileUpdateAttributes["reachable_timer"]),
+					resource.TestCheckResourceAttr(testResourceName, "retransmit_interval", accTestPolicyIpv6NdraProfileUpdateAttributes["retransmit_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.0.hop_limit", accTestPolicyIpv6NdraProfileUpdateAttributes["hop_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.0.ra_interval", accTestPolicyIpv6NdraProfileUpdateAttributes["ra_interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.0.router_preference", accTestPolicyIpv6NdraProfileUpdateAttributes["router_preference"]),
+					resource.TestCheckResourceAttr(testResourceName, "dns_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "dns_config.0.dns_server.0", accTestPolicyIpv6NdraProfileUpdateAttributes["dns_server"]),
+					resource.TestCheckResourceAttr(testResourceName, "dns_config.0.domain_name.0", accTestPolicyIpv6NdraProfileUpdateAttributes["domain_name"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileMinimalistic(withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6NdraProfileExists(accTestPolicyIpv6NdraProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "ra_mode", "SLAAC_DNS_THROUGH_RA"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6NdraProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipv6_ndra_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileMinimalistic(false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6NdraProfile_importBasic_multitenancy(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipv6_ndra_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyMultitenancy(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileMinimalistic(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpv6NdraProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Ipv6NdraProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Ipv6NdraProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIpv6NdraProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Ipv6NdraProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipv6_ndra_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIpv6NdraProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Ipv6NdraProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIpv6NdraProfileTemplate(createFlow, withContext bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpv6NdraProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpv6NdraProfileUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipv6_ndra_profile" "test" {
+%s
+  display_name        = "%s"
+  description         = "%s"
+  ra_mode             = "%s"
+  reachable_timer     = %s
+  retransmit_interval = %s
+
+  ra_config {
+    hop_limit         = %s
+    ra_interval       = %s
+    router_preference = "%s"
+  }
+
+  dns_config {
+    dns_server  = ["%s"]
+    domain_name = ["%s"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["ra_mode"], attrMap["reachable_timer"], attrMap["retransmit_interval"], attrMap["hop_limit"], attrMap["ra_interval"], attrMap["router_preference"], attrMap["dns_server"], attrMap["domain_name"])
+}
+
+func testAccNsxtPolicyIpv6NdraProfileMinimalistic(withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipv6_ndra_profile" "test" {
+%s
+  display_name = "%s"
+}`, context, accTestPolicyIpv6NdraProfileUpdateAttributes["display_name"])
+}
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -130,20 +131,20 @@ func deleteNsxtPolicyL2VpnService(connector client.Connector, gwID string, local
 
 func resourceNsxtPolicyL2VpnServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
-	s := strings.Split(importID, "/")
 	err := fmt.Errorf("Expected policy path for the L2 VPN Service, got %s", importID)
 	// The policy path of L2 VPN Service should be like /infra/tier-0s/aaa/locale-services/bbb/l2vpn-services/ccc
 	// or /infra/tier-0s/aaa/l2vpn-services/bbb
-	if len(s) != 8 && len(s) != 6 {
-		return nil, err
+	rd, pathErr := nsxtPolicyPathResourceImporterHelper(d, m)
+	if errors.Is(pathErr, ErrNotAPolicyPath) {
+		return rd, err
+	} else if pathErr != nil {
+		return rd, pathErr
 	}
-	useLocaleService := (len(s) == 8)
-	d.SetId(s[len(s)-1])
-	s = strings.Split(importID, "/l2vpn-services/")
+	s := strings.Split(importID, "/l2vpn-services/")
 	if len(s) != 2 {
-		return []*schema.ResourceData{d}, err
+		return rd, err
 	}
-	if useLocaleService {
+	if strings.Contains(s[0], "/locale-services/") {
 		d.Set("locale_service_path", s[0])
 	} else {
 		d.Set("gateway_path", s[0])
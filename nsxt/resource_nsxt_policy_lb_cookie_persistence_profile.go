@@ -0,0 +1,348 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lBCookiePersistenceProfileCookieModeValues = []string{
+	model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+	model.LBCookiePersistenceProfile_COOKIE_MODE_PREFIX,
+	model.LBCookiePersistenceProfile_COOKIE_MODE_REWRITE,
+}
+
+var lBCookieTimeTypeValues = []string{
+	model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME,
+	model.LBCookieTime_TYPE_LBPERSISTENCECOOKIETIME,
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBCookiePersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBCookiePersistenceProfileRead,
+		Update: resourceNsxtPolicyLBCookiePersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBPersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":             getNsxIDSchema(),
+			"path":               getPathSchema(),
+			"display_name":       getDisplayNameSchema(),
+			"description":        getDescriptionSchema(),
+			"revision":           getRevisionSchema(),
+			"tag":                getTagsSchema(),
+			"persistence_shared": getLbPersistenceSharedSchema(),
+			"cookie_mode": {
+				Type:         schema.TypeString,
+				Description:  "Cookie persistence mode",
+				Optional:     true,
+				Default:      model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+				ValidateFunc: validation.StringInSlice(lBCookiePersistenceProfileCookieModeValues, false),
+			},
+			"cookie_name": {
+				Type:        schema.TypeString,
+				Description: "Cookie name",
+				Optional:    true,
+				Default:     "NSXLB",
+			},
+			"cookie_domain": {
+				Type:        schema.TypeString,
+				Description: "HTTP cookie domain, only available for insert mode",
+				Optional:    true,
+			},
+			"cookie_path": {
+				Type:        schema.TypeString,
+				Description: "HTTP cookie path, only available for insert mode",
+				Optional:    true,
+			},
+			"cookie_fallback": {
+				Type:        schema.TypeBool,
+				Description: "If true, once the server pointed by the cookie is down, a new server is selected. Otherwise the request is rejected",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_garble": {
+				Type:        schema.TypeBool,
+				Description: "If true, cookie value (server IP and port) is encrypted",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_httponly": {
+				Type:        schema.TypeBool,
+				Description: "If true, prevents a script running in the browser from accessing the cookie. Only available for insert mode",
+				Optional:    true,
+				Default:     false,
+			},
+			"cookie_secure": {
+				Type:        schema.TypeBool,
+				Description: "If true, prevents the browser from sending the cookie over http. Only available for insert mode",
+				Optional:    true,
+				Default:     false,
+			},
+			"cookie_time": {
+				Type:        schema.TypeList,
+				Description: "Cookie expiration settings, only available for insert mode",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Type of cookie expiration",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lBCookieTimeTypeValues, false),
+						},
+						"max_idle": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum interval in seconds the cookie is valid for from the last time it was seen in a request",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_life": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum interval in seconds the cookie is valid for from the first time it was seen in a request. Only relevant for session cookie",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyLBPersistenceProfileExists(id, connector, isGlobalManager)
+}
+
+func getPolicyLBCookieTimeFromSchema(d *schema.ResourceData, converter *bindings.TypeConverter) (*data.StructValue, error) {
+	cookieTimes := d.Get("cookie_time").([]interface{})
+	if len(cookieTimes) == 0 || cookieTimes[0] == nil {
+		return nil, nil
+	}
+
+	cookieTime := cookieTimes[0].(map[string]interface{})
+	timeType := cookieTime["type"].(string)
+	maxIdle := int64(cookieTime["max_idle"].(int))
+	maxLife := int64(cookieTime["max_life"].(int))
+
+	var dataValue data.DataValue
+	var errs []error
+	if timeType == model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME {
+		obj := model.LBSessionCookieTime{
+			Type_: timeType,
+		}
+		if maxIdle > 0 {
+			obj.CookieMaxIdle = &maxIdle
+		}
+		if maxLife > 0 {
+			obj.CookieMaxLife = &maxLife
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.LBSessionCookieTimeBindingType())
+	} else {
+		if maxLife > 0 {
+			return nil, fmt.Errorf("max_life is only relevant for %s cookie time", model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME)
+		}
+		obj := model.LBPersistenceCookieTime{
+			Type_: timeType,
+		}
+		if maxIdle > 0 {
+			obj.CookieMaxIdle = &maxIdle
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.LBPersistenceCookieTimeBindingType())
+	}
+	if errs != nil {
+		return nil, fmt.Errorf("Error converting cookie time: %v", errs[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func setPolicyLBCookieTimeInSchema(d *schema.ResourceData, cookieTime *data.StructValue, converter *bindings.TypeConverter) error {
+	if cookieTime == nil {
+		d.Set("cookie_time", nil)
+		return nil
+	}
+
+	baseObj, errs := converter.ConvertToGolang(cookieTime, model.LBCookieTimeBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting cookie time: %v", errs[0])
+	}
+
+	elem := make(map[string]interface{})
+	timeType := baseObj.(model.LBCookieTime).Type_
+	elem["type"] = timeType
+	if timeType == model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME {
+		obj, errs := converter.ConvertToGolang(cookieTime, model.LBSessionCookieTimeBindingType())
+		if errs != nil {
+			return fmt.Errorf("Error converting cookie time: %v", errs[0])
+		}
+		sessionTime := obj.(model.LBSessionCookieTime)
+		if sessionTime.CookieMaxIdle != nil {
+			elem["max_idle"] = int(*sessionTime.CookieMaxIdle)
+		}
+		if sessionTime.CookieMaxLife != nil {
+			elem["max_life"] = int(*sessionTime.CookieMaxLife)
+		}
+	} else {
+		obj, errs := converter.ConvertToGolang(cookieTime, model.LBPersistenceCookieTimeBindingType())
+		if errs != nil {
+			return fmt.Errorf("Error converting cookie time: %v", errs[0])
+		}
+		persistenceTime := obj.(model.LBPersistenceCookieTime)
+		if persistenceTime.CookieMaxIdle != nil {
+			elem["max_idle"] = int(*persistenceTime.CookieMaxIdle)
+		}
+	}
+
+	return d.Set("cookie_time", []interface{}{elem})
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	cookieMode := d.Get("cookie_mode").(string)
+	cookieName := d.Get("cookie_name").(string)
+	cookieDomain := d.Get("cookie_domain").(string)
+	cookiePath := d.Get("cookie_path").(string)
+	cookieFallback := d.Get("cookie_fallback").(bool)
+	cookieGarble := d.Get("cookie_garble").(bool)
+	cookieHttponly := d.Get("cookie_httponly").(bool)
+	cookieSecure := d.Get("cookie_secure").(bool)
+	cookieTime, err := getPolicyLBCookieTimeFromSchema(d, converter)
+	if err != nil {
+		return err
+	}
+
+	obj := model.LBCookiePersistenceProfile{
+		DisplayName:       &displayName,
+		Description:       &description,
+		Tags:              tags,
+		PersistenceShared: &persistenceShared,
+		CookieMode:        &cookieMode,
+		CookieName:        &cookieName,
+		CookieFallback:    &cookieFallback,
+		CookieGarble:      &cookieGarble,
+		CookieTime:        cookieTime,
+		ResourceType:      model.LBPersistenceProfile_RESOURCE_TYPE_LBCOOKIEPERSISTENCEPROFILE,
+	}
+
+	if cookieMode == model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT {
+		obj.CookieHttponly = &cookieHttponly
+		obj.CookieSecure = &cookieSecure
+		if len(cookieDomain) > 0 {
+			obj.CookieDomain = &cookieDomain
+		}
+		if len(cookiePath) > 0 {
+			obj.CookiePath = &cookiePath
+		}
+	} else if len(cookieDomain) > 0 || len(cookiePath) > 0 || cookieTime != nil {
+		return fmt.Errorf("cookie_domain, cookie_path and cookie_time are only available for %s cookie mode", model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT)
+	}
+
+	log.Printf("[INFO] Patching LBCookiePersistenceProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBCookiePersistenceProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBCookiePersistenceProfile %s", errs[0])
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBCookiePersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBCookiePersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBCookiePersistenceProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBCookiePersistenceProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBPersistenceProfile with id %s is not of type LBCookiePersistenceProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBCookiePersistenceProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("persistence_shared", profile.PersistenceShared)
+	d.Set("cookie_mode", profile.CookieMode)
+	d.Set("cookie_name", profile.CookieName)
+	d.Set("cookie_domain", profile.CookieDomain)
+	d.Set("cookie_path", profile.CookiePath)
+	d.Set("cookie_fallback", profile.CookieFallback)
+	d.Set("cookie_garble", profile.CookieGarble)
+	if profile.CookieHttponly != nil {
+		d.Set("cookie_httponly", profile.CookieHttponly)
+	}
+	if profile.CookieSecure != nil {
+		d.Set("cookie_secure", profile.CookieSecure)
+	}
+
+	return setPolicyLBCookieTimeInSchema(d, profile.CookieTime, converter)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBCookiePersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
@@ -0,0 +1,228 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBCookiePersistenceProfileCreateAttributes = map[string]string{
+	"display_name":       getAccTestResourceName(),
+	"description":        "terraform created",
+	"cookie_mode":        "INSERT",
+	"cookie_name":        "cookie1",
+	"cookie_domain":      "example.com",
+	"cookie_path":        "/app",
+	"cookie_fallback":    "true",
+	"cookie_garble":      "true",
+	"cookie_httponly":    "true",
+	"cookie_secure":      "true",
+	"persistence_shared": "true",
+}
+
+var accTestPolicyLBCookiePersistenceProfileUpdateAttributes = map[string]string{
+	"display_name":       getAccTestResourceName(),
+	"description":        "terraform updated",
+	"cookie_mode":        "INSERT",
+	"cookie_name":        "cookie2",
+	"cookie_domain":      "test.com",
+	"cookie_path":        "/web",
+	"cookie_fallback":    "false",
+	"cookie_garble":      "false",
+	"cookie_httponly":    "false",
+	"cookie_secure":      "false",
+	"persistence_shared": "false",
+}
+
+func TestAccResourceNsxtPolicyLBCookiePersistenceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_cookie_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBCookiePersistenceProfileCheckDestroy(state, accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBCookiePersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBCookiePersistenceProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBCookiePersistenceProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_domain", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_domain"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_path", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_path"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_fallback"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_garble"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_httponly", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_httponly"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_secure", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_secure"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBCookiePersistenceProfileCreateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.type", "LBSessionCookieTime"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.max_idle", "100"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBCookiePersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_domain", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_domain"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_path", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_path"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_fallback"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_garble"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_httponly", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_httponly"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_secure", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_secure"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["persistence_shared"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBCookiePersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", "INSERT"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", "NSXLB"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBCookiePersistenceProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_cookie_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBCookiePersistenceProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBCookiePersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_cookie_persistence_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBCookiePersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBCookiePersistenceProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBCookiePersistenceProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_cookie_persistence_profile" "test" {
+  display_name       = "%s"
+  description        = "%s"
+  cookie_mode        = "%s"
+  cookie_name        = "%s"
+  cookie_domain      = "%s"
+  cookie_path        = "%s"
+  cookie_fallback    = %s
+  cookie_garble      = %s
+  cookie_httponly    = %s
+  cookie_secure      = %s
+  persistence_shared = %s
+
+  cookie_time {
+    type     = "LBSessionCookieTime"
+    max_idle = 100
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["cookie_mode"], attrMap["cookie_name"], attrMap["cookie_domain"], attrMap["cookie_path"], attrMap["cookie_fallback"], attrMap["cookie_garble"], attrMap["cookie_httponly"], attrMap["cookie_secure"], attrMap["persistence_shared"])
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_cookie_persistence_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,144 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBGenericPersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBGenericPersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBGenericPersistenceProfileRead,
+		Update: resourceNsxtPolicyLBGenericPersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBPersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                   getNsxIDSchema(),
+			"path":                     getPathSchema(),
+			"display_name":             getDisplayNameSchema(),
+			"description":              getDescriptionSchema(),
+			"revision":                 getRevisionSchema(),
+			"tag":                      getTagsSchema(),
+			"persistence_shared":       getLbPersistenceSharedSchema(),
+			"ha_persistence_mirroring": getLbHaPersistenceMirroringSchema(),
+			"timeout":                  getLbPersistenceTimeoutSchema(),
+		},
+	}
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyLBPersistenceProfileExists(id, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	haPersistenceMirroring := d.Get("ha_persistence_mirroring").(bool)
+	timeout := int64(d.Get("timeout").(int))
+
+	obj := model.LBGenericPersistenceProfile{
+		DisplayName:                   &displayName,
+		Description:                   &description,
+		Tags:                          tags,
+		PersistenceShared:             &persistenceShared,
+		HaPersistenceMirroringEnabled: &haPersistenceMirroring,
+		Timeout:                       &timeout,
+		ResourceType:                  model.LBPersistenceProfile_RESOURCE_TYPE_LBGENERICPERSISTENCEPROFILE,
+	}
+
+	log.Printf("[INFO] Patching LBGenericPersistenceProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBGenericPersistenceProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBGenericPersistenceProfile %s", errs[0])
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBGenericPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBGenericPersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBGenericPersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBGenericPersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBGenericPersistenceProfile ID")
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBGenericPersistenceProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBGenericPersistenceProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBPersistenceProfile with id %s is not of type LBGenericPersistenceProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBGenericPersistenceProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("persistence_shared", profile.PersistenceShared)
+	d.Set("ha_persistence_mirroring", profile.HaPersistenceMirroringEnabled)
+	d.Set("timeout", profile.Timeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBGenericPersistenceProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBGenericPersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBGenericPersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBGenericPersistenceProfileRead(d, m)
+}
@@ -37,7 +37,7 @@ func resourceNsxtPolicyLBPool() *schema.Resource {
 		Update: resourceNsxtPolicyLBPoolUpdate,
 		Delete: resourceNsxtPolicyLBPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,6 +81,12 @@ func resourceNsxtPolicyLBPool() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"snat": getPolicyPoolSnatSchema(),
+			"ignore_unmanaged_members": {
+				Type:        schema.TypeBool,
+				Description: "Ignore pool members that are not configured in this resource, such as members managed by nsxt_policy_lb_pool_member resource",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -216,6 +222,27 @@ func setPolicyPoolMembersInSchema(d *schema.ResourceData, members []model.LBPool
 	return err
 }
 
+func getPolicyPoolMemberKeysFromList(members []interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, member := range members {
+		data := member.(map[string]interface{})
+		keys[getPolicyLBPoolMemberKey(data["ip_address"].(string), data["port"].(string))] = true
+	}
+	return keys
+}
+
+// Returns members from the list that are present (or absent, if include is false)
+// in the given key set
+func filterPolicyPoolMembers(members []model.LBPoolMember, keys map[string]bool, include bool) []model.LBPoolMember {
+	var result []model.LBPoolMember
+	for _, member := range members {
+		if keys[getPolicyLBPoolMemberKeyFromModel(member)] == include {
+			result = append(result, member)
+		}
+	}
+	return result
+}
+
 func getPolicyPoolMemberGroupFromSchema(d *schema.ResourceData) *model.LBPoolMemberGroup {
 	members := d.Get("member_group").([]interface{})
 	for _, member := range members {
@@ -522,11 +549,12 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	err = setPolicyPoolMembersInSchema(d, obj.Members)
-	if err != nil {
-		return err
+	members := obj.Members
+	if d.Get("ignore_unmanaged_members").(bool) {
+		configuredKeys := getPolicyPoolMemberKeysFromList(d.Get("member").([]interface{}))
+		members = filterPolicyPoolMembers(members, configuredKeys, true)
 	}
-	err = setPolicyPoolMemberGroupInSchema(d, obj.MemberGroup)
+	err = setPolicyPoolMembersInSchema(d, members)
 	if err != nil {
 		return err
 	}
@@ -599,7 +627,41 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 		Revision:               &revision,
 	}
 
-	_, err = client.Update(id, obj)
+	// Members might be modified concurrently by nsxt_policy_lb_pool_member resources
+	poolPath := d.Get("path").(string)
+	lockPolicyParent(poolPath)
+	defer unlockPolicyParent(poolPath)
+
+	if d.Get("ignore_unmanaged_members").(bool) {
+		// Members currently configured in this resource are owned by it, the rest
+		// of the members on NSX should be preserved
+		ownedKeys := getPolicyPoolMemberKeysFromList(d.Get("member").([]interface{}))
+		oldIgnore, _ := d.GetChange("ignore_unmanaged_members")
+		if oldIgnore.(bool) {
+			// Members removed from configuration are owned as well, so that they get deleted.
+			// When the flag was just turned on (for instance, after import), prior state
+			// holds all pool members, hence it can not be used to detect ownership
+			oldMembers, _ := d.GetChange("member")
+			for key := range getPolicyPoolMemberKeysFromList(oldMembers.([]interface{})) {
+				ownedKeys[key] = true
+			}
+		}
+
+		doUpdate := func() error {
+			currentObj, err := client.Get(id)
+			if err != nil {
+				return err
+			}
+			obj.Members = append(filterPolicyPoolMembers(currentObj.Members, ownedKeys, false), members...)
+			obj.Revision = currentObj.Revision
+			_, err = client.Update(id, obj)
+			return err
+		}
+		commonProviderConfig := getCommonProviderConfig(m)
+		err = retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+	} else {
+		_, err = client.Update(id, obj)
+	}
 	if err != nil {
 		return handleUpdateError("LBPool", id, err)
 	}
@@ -0,0 +1,292 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBPoolMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBPoolMemberCreate,
+		Read:   resourceNsxtPolicyLBPoolMemberRead,
+		Update: resourceNsxtPolicyLBPoolMemberUpdate,
+		Delete: resourceNsxtPolicyLBPoolMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyLBPoolMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool_path": getPolicyPathSchema(true, true, "Policy path of the LB pool this member belongs to"),
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "Pool member IP address",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"port": {
+				Type:         schema.TypeString,
+				Description:  "If port is specified, all connections will be sent to this port. If unset, the same port the client connected to will be used",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSinglePort(),
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Pool member name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"admin_state": {
+				Type:         schema.TypeString,
+				Description:  "Member admin state",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(memberAdminStateTypeValues, false),
+				Default:      "ENABLED",
+			},
+			"backup_member": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether this is a backup pool member",
+				Optional:    true,
+				Default:     false,
+			},
+			"max_concurrent_connections": {
+				Type:        schema.TypeInt,
+				Description: "To ensure members are not overloaded, connections to a member can be capped by the load balancer. If it is not specified, it means that connections are unlimited",
+				Optional:    true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Description:  "Pool member weight is used for WEIGHTED_ROUND_ROBIN balancing algorithm",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 256),
+			},
+		},
+	}
+}
+
+// Pool members do not have an ID of their own, and are identified within
+// the pool by IP address and port
+func getPolicyLBPoolMemberKey(ipAddress string, port string) string {
+	if port == "" {
+		return ipAddress
+	}
+	// IPv6 address is enclosed in brackets, so that the port can be told apart
+	return net.JoinHostPort(ipAddress, port)
+}
+
+func getPolicyLBPoolMemberKeyFromModel(member model.LBPoolMember) string {
+	ipAddress := ""
+	port := ""
+	if member.IpAddress != nil {
+		ipAddress = *member.IpAddress
+	}
+	if member.Port != nil {
+		port = *member.Port
+	}
+	return getPolicyLBPoolMemberKey(ipAddress, port)
+}
+
+func parsePolicyLBPoolMemberKey(key string) (string, string, error) {
+	if net.ParseIP(key) != nil {
+		return key, "", nil
+	}
+	ipAddress, port, err := net.SplitHostPort(key)
+	if err != nil || net.ParseIP(ipAddress) == nil || port == "" {
+		return "", "", fmt.Errorf("Failed to parse pool member %s, expected <ip-address>, <ip-address>:<port> or [<ipv6-address>]:<port>", key)
+	}
+	return ipAddress, port, nil
+}
+
+func policyLBPoolMemberIndex(key string, members []model.LBPoolMember) int {
+	for i, member := range members {
+		if getPolicyLBPoolMemberKeyFromModel(member) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func getPolicyLBPoolMemberFromSchema(d *schema.ResourceData) model.LBPoolMember {
+	displayName := d.Get("display_name").(string)
+	adminState := d.Get("admin_state").(string)
+	backupMember := d.Get("backup_member").(bool)
+	address := d.Get("ip_address").(string)
+	port := d.Get("port").(string)
+	weight := int64(d.Get("weight").(int))
+	maxConnections := int64(d.Get("max_concurrent_connections").(int))
+
+	member := model.LBPoolMember{
+		AdminState:   &adminState,
+		BackupMember: &backupMember,
+		IpAddress:    &address,
+		Weight:       &weight,
+	}
+	if displayName != "" {
+		member.DisplayName = &displayName
+	}
+	if maxConnections > 0 {
+		member.MaxConcurrentConnections = &maxConnections
+	}
+	if port != "" {
+		member.Port = &port
+	}
+
+	return member
+}
+
+// Read the pool, apply modification to its member list and update the pool
+// with revision of the read object. Update is repeated if the pool was modified
+// by someone else in the meantime.
+func policyLBPoolMembersReadModifyWrite(m interface{}, poolPath string, modify func(members []model.LBPoolMember) ([]model.LBPoolMember, error)) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewLbPoolsClient(connector)
+	poolID := getPolicyIDFromPath(poolPath)
+
+	doUpdate := func() error {
+		pool, err := client.Get(poolID)
+		if err != nil {
+			return err
+		}
+
+		members, err := modify(pool.Members)
+		if err != nil {
+			return err
+		}
+		pool.Members = members
+
+		_, err = client.Update(poolID, pool)
+		return err
+	}
+
+	lockPolicyParent(poolPath)
+	defer unlockPolicyParent(poolPath)
+
+	commonProviderConfig := getCommonProviderConfig(m)
+	return retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+}
+
+func resourceNsxtPolicyLBPoolMemberCreate(d *schema.ResourceData, m interface{}) error {
+	poolPath := d.Get("pool_path").(string)
+	member := getPolicyLBPoolMemberFromSchema(d)
+	key := getPolicyLBPoolMemberKeyFromModel(member)
+
+	log.Printf("[INFO] Adding member %s to LBPool %s", key, poolPath)
+	err := policyLBPoolMembersReadModifyWrite(m, poolPath, func(members []model.LBPoolMember) ([]model.LBPoolMember, error) {
+		if policyLBPoolMemberIndex(key, members) >= 0 {
+			return nil, errors.AlreadyExists{}
+		}
+		return append(members, member), nil
+	})
+	if err != nil {
+		return handleCreateError("LBPoolMember", key, err)
+	}
+
+	d.SetId(key)
+
+	return resourceNsxtPolicyLBPoolMemberRead(d, m)
+}
+
+func resourceNsxtPolicyLBPoolMemberRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewLbPoolsClient(connector)
+
+	key := d.Id()
+	if key == "" {
+		return fmt.Errorf("Error obtaining LBPoolMember ID")
+	}
+	poolPath := d.Get("pool_path").(string)
+
+	pool, err := client.Get(getPolicyIDFromPath(poolPath))
+	if err != nil {
+		return handleReadError(d, "LBPoolMember", key, err)
+	}
+
+	i := policyLBPoolMemberIndex(key, pool.Members)
+	if i < 0 {
+		log.Printf("[DEBUG] LBPoolMember %s not found in LBPool %s", key, poolPath)
+		d.SetId("")
+		return nil
+	}
+	member := pool.Members[i]
+
+	d.Set("ip_address", member.IpAddress)
+	d.Set("port", member.Port)
+	d.Set("display_name", member.DisplayName)
+	d.Set("admin_state", member.AdminState)
+	d.Set("backup_member", member.BackupMember)
+	d.Set("max_concurrent_connections", member.MaxConcurrentConnections)
+	d.Set("weight", member.Weight)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBPoolMemberUpdate(d *schema.ResourceData, m interface{}) error {
+	key := d.Id()
+	poolPath := d.Get("pool_path").(string)
+	member := getPolicyLBPoolMemberFromSchema(d)
+
+	err := policyLBPoolMembersReadModifyWrite(m, poolPath, func(members []model.LBPoolMember) ([]model.LBPoolMember, error) {
+		i := policyLBPoolMemberIndex(key, members)
+		if i < 0 {
+			return nil, errors.NotFound{}
+		}
+		members[i] = member
+		return members, nil
+	})
+	if err != nil {
+		return handleUpdateError("LBPoolMember", key, err)
+	}
+
+	return resourceNsxtPolicyLBPoolMemberRead(d, m)
+}
+
+func resourceNsxtPolicyLBPoolMemberDelete(d *schema.ResourceData, m interface{}) error {
+	key := d.Id()
+	poolPath := d.Get("pool_path").(string)
+
+	err := policyLBPoolMembersReadModifyWrite(m, poolPath, func(members []model.LBPoolMember) ([]model.LBPoolMember, error) {
+		i := policyLBPoolMemberIndex(key, members)
+		if i < 0 {
+			// Member is already gone
+			return members, nil
+		}
+		return append(members[:i], members[i+1:]...), nil
+	})
+	if err != nil && !isNotFoundError(err) {
+		return handleDeleteError("LBPoolMember", key, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLBPoolMemberImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	i := strings.LastIndex(importID, "/")
+	if i <= 0 || !isPolicyPath(importID[:i]) {
+		return nil, fmt.Errorf("Please provide <pool-path>/<ip-address>:<port> as an input")
+	}
+
+	poolPath := importID[:i]
+	ipAddress, port, err := parsePolicyLBPoolMemberKey(importID[i+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(getPolicyLBPoolMemberKey(ipAddress, port))
+	d.Set("pool_path", poolPath)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,230 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+var accTestPolicyLBPoolMemberCreateAttributes = map[string]string{
+	"display_name":               "member1",
+	"admin_state":                "ENABLED",
+	"backup_member":              "false",
+	"max_concurrent_connections": "7",
+	"weight":                     "2",
+}
+
+var accTestPolicyLBPoolMemberUpdateAttributes = map[string]string{
+	"display_name":               "member1-updated",
+	"admin_state":                "DISABLED",
+	"backup_member":              "true",
+	"max_concurrent_connections": "100",
+	"weight":                     "5",
+}
+
+func TestAccResourceNsxtPolicyLBPoolMember_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_pool_member.test"
+	poolResourceName := "nsxt_policy_lb_pool.test"
+	poolName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPoolMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolMemberResourceTemplate(poolName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPoolMemberExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "5.5.5.5"),
+					resource.TestCheckResourceAttr(testResourceName, "port", "77"),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBPoolMemberCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", accTestPolicyLBPoolMemberCreateAttributes["admin_state"]),
+					resource.TestCheckResourceAttr(testResourceName, "backup_member", accTestPolicyLBPoolMemberCreateAttributes["backup_member"]),
+					resource.TestCheckResourceAttr(testResourceName, "max_concurrent_connections", accTestPolicyLBPoolMemberCreateAttributes["max_concurrent_connections"]),
+					resource.TestCheckResourceAttr(testResourceName, "weight", accTestPolicyLBPoolMemberCreateAttributes["weight"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool_path"),
+					resource.TestCheckResourceAttr(poolResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(poolResourceName, "member.0.ip_address", "5.5.5.3"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBPoolMemberResourceTemplate(poolName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPoolMemberExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "5.5.5.5"),
+					resource.TestCheckResourceAttr(testResourceName, "port", "77"),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBPoolMemberUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", accTestPolicyLBPoolMemberUpdateAttributes["admin_state"]),
+					resource.TestCheckResourceAttr(testResourceName, "backup_member", accTestPolicyLBPoolMemberUpdateAttributes["backup_member"]),
+					resource.TestCheckResourceAttr(testResourceName, "max_concurrent_connections", accTestPolicyLBPoolMemberUpdateAttributes["max_concurrent_connections"]),
+					resource.TestCheckResourceAttr(testResourceName, "weight", accTestPolicyLBPoolMemberUpdateAttributes["weight"]),
+					resource.TestCheckResourceAttr(poolResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(poolResourceName, "member.0.ip_address", "5.5.5.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBPoolMember_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_pool_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPoolMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolMemberResourceTemplate(getAccTestResourceName(), true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyLBPoolMemberImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPoolMemberImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_lb_pool_member.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy LBPoolMember resource %s not found in resources", "nsxt_policy_lb_pool_member.test")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("NSX Policy LBPoolMember resource ID not set in resources")
+	}
+	poolPath := rs.Primary.Attributes["pool_path"]
+	if poolPath == "" {
+		return "", fmt.Errorf("NSX Policy LBPoolMember pool_path not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", poolPath, resourceID), nil
+}
+
+func testAccNsxtPolicyLBPoolMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		nsxClient := infra.NewLbPoolsClient(connector)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBPoolMember resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBPoolMember resource ID not set in resources")
+		}
+
+		poolPath := rs.Primary.Attributes["pool_path"]
+		pool, err := nsxClient.Get(getPolicyIDFromPath(poolPath))
+		if err != nil {
+			return fmt.Errorf("Error while retrieving policy LBPool %s. Error: %v", poolPath, err)
+		}
+		if policyLBPoolMemberIndex(resourceID, pool.Members) < 0 {
+			return fmt.Errorf("Policy LBPoolMember %s not found in LBPool %s", resourceID, poolPath)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBPoolMemberCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	nsxClient := infra.NewLbPoolsClient(connector)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_pool_member" {
+			continue
+		}
+
+		poolPath := rs.Primary.Attributes["pool_path"]
+		pool, err := nsxClient.Get(getPolicyIDFromPath(poolPath))
+		if err == nil && policyLBPoolMemberIndex(rs.Primary.ID, pool.Members) >= 0 {
+			return fmt.Errorf("Policy LBPoolMember %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBPoolMemberResourceTemplate(poolName string, createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBPoolMemberCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBPoolMemberUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_pool" "test" {
+  display_name             = "%s"
+  ignore_unmanaged_members = true
+
+  member {
+    display_name = "member2"
+    ip_address   = "5.5.5.3"
+  }
+}
+
+resource "nsxt_policy_lb_pool_member" "test" {
+  pool_path                  = nsxt_policy_lb_pool.test.path
+  ip_address                 = "5.5.5.5"
+  port                       = "77"
+  display_name               = "%s"
+  admin_state                = "%s"
+  backup_member              = %s
+  max_concurrent_connections = %s
+  weight                     = %s
+}`, poolName, attrMap["display_name"], attrMap["admin_state"], attrMap["backup_member"], attrMap["max_concurrent_connections"], attrMap["weight"])
+}
+
+func TestPolicyLBPoolMemberKey(t *testing.T) {
+	tests := []struct {
+		ipAddress string
+		port      string
+		key       string
+	}{
+		{ipAddress: "10.0.0.1", port: "", key: "10.0.0.1"},
+		{ipAddress: "10.0.0.1", port: "80", key: "10.0.0.1:80"},
+		{ipAddress: "fd00::1", port: "", key: "fd00::1"},
+		{ipAddress: "fd00::1", port: "80", key: "[fd00::1]:80"},
+		{ipAddress: "fd00::1:80", port: "", key: "fd00::1:80"},
+	}
+
+	for _, test := range tests {
+		key := getPolicyLBPoolMemberKey(test.ipAddress, test.port)
+		if key != test.key {
+			t.Errorf("expected key %s for %s and port %s, got %s", test.key, test.ipAddress, test.port, key)
+		}
+
+		ipAddress, port, err := parsePolicyLBPoolMemberKey(key)
+		if err != nil {
+			t.Errorf("failed to parse key %s: %v", key, err)
+			continue
+		}
+		if ipAddress != test.ipAddress || port != test.port {
+			t.Errorf("parsing %s: expected %s and port %s, got %s and port %s", key, test.ipAddress, test.port, ipAddress, port)
+		}
+	}
+}
+
+func TestParsePolicyLBPoolMemberKeyInvalid(t *testing.T) {
+	for _, key := range []string{"", "abc", "abc:80", "10.0.0.1:", "[fd00::1]", "fd00::1]:80", "10.0.0.1:80:90"} {
+		if _, _, err := parsePolicyLBPoolMemberKey(key); err == nil {
+			t.Errorf("expected error parsing %s", key)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBServerSslProfileCreateAttributes = map[string]string{
+	"display_name":          getAccTestResourceName(),
+	"description":           "terraform created",
+	"cipher_group_label":    "CUSTOM",
+	"ciphers":               "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA",
+	"protocols":             "TLS_V1_2",
+	"session_cache_enabled": "true",
+}
+
+var accTestPolicyLBServerSslProfileUpdateAttributes = map[string]string{
+	"display_name":          getAccTestResourceName(),
+	"description":           "terraform updated",
+	"cipher_group_label":    "CUSTOM",
+	"ciphers":               "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	"protocols":             "TLS_V1_1",
+	"session_cache_enabled": "false",
+}
+
+func TestAccResourceNsxtPolicyLBServerSslProfile_basic(t *testing.T) {
+	testAccResourceNsxtPolicyLBServerSslProfileBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyLBServerSslProfile_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyLBServerSslProfileBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyLBServerSslProfileBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_lb_server_ssl_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(testAccGetSessionContext(), state, accTestPolicyLBServerSslProfileUpdateAttributes["display_name"], "nsxt_policy_lb_server_ssl_profile", resourceNsxtPolicyLBServerSslProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBServerSslProfileTemplate(true, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testAccGetSessionContext(), testResourceName, resourceNsxtPolicyLBServerSslProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBServerSslProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBServerSslProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cipher_group_label", accTestPolicyLBServerSslProfileCreateAttributes["cipher_group_label"]),
+					resource.TestCheckResourceAttr(testResourceName, "ciphers.0", accTestPolicyLBServerSslProfileCreateAttributes["ciphers"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "is_fips"),
+					resource.TestCheckResourceAttrSet(testResourceName, "is_secure"),
+					resource.TestCheckResourceAttr(testResourceName, "protocols.0", accTestPolicyLBServerSslProfileCreateAttributes["protocols"]),
+					resource.TestCheckResourceAttr(testResourceName, "session_cache_enabled", accTestPolicyLBServerSslProfileCreateAttributes["session_cache_enabled"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBServerSslProfileTemplate(false, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testAccGetSessionContext(), testResourceName, resourceNsxtPolicyLBServerSslProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBServerSslProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBServerSslProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cipher_group_label", accTestPolicyLBServerSslProfileUpdateAttributes["cipher_group_label"]),
+					resource.TestCheckResourceAttr(testResourceName, "ciphers.0", accTestPolicyLBServerSslProfileUpdateAttributes["ciphers"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "is_fips"),
+					resource.TestCheckResourceAttrSet(testResourceName, "is_secure"),
+					resource.TestCheckResourceAttr(testResourceName, "protocols.0", accTestPolicyLBServerSslProfileUpdateAttributes["protocols"]),
+					resource.TestCheckResourceAttr(testResourceName, "session_cache_enabled", accTestPolicyLBServerSslProfileUpdateAttributes["session_cache_enabled"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBServerSslProfileMinimalistic(withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testAccGetSessionContext(), testResourceName, resourceNsxtPolicyLBServerSslProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBServerSslProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_server_ssl_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(testAccGetSessionContext(), state, name, "nsxt_policy_lb_server_ssl_profile", resourceNsxtPolicyLBServerSslProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBServerSslProfileMinimalistic(false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBServerSslProfile_importBasic_multitenancy(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_server_ssl_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyMultitenancy(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(testAccGetSessionContext(), state, name, "nsxt_policy_lb_server_ssl_profile", resourceNsxtPolicyLBServerSslProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBServerSslProfileMinimalistic(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBServerSslProfileTemplate(createFlow, withContext bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBServerSslProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBServerSslProfileUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_server_ssl_profile" "test" {
+%s
+  display_name          = "%s"
+  description           = "%s"
+  cipher_group_label    = "%s"
+  ciphers               = ["%s"]
+  protocols             = ["%s"]
+  session_cache_enabled = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["cipher_group_label"], attrMap["ciphers"], attrMap["protocols"], attrMap["session_cache_enabled"])
+}
+
+func testAccNsxtPolicyLBServerSslProfileMinimalistic(withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_server_ssl_profile" "test" {
+%s
+  display_name = "%s"
+}`, context, accTestPolicyLBServerSslProfileUpdateAttributes["display_name"])
+}
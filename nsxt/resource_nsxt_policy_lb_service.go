@@ -39,7 +39,7 @@ func resourceNsxtPolicyLBService() *schema.Resource {
 		Update: resourceNsxtPolicyLBServiceUpdate,
 		Delete: resourceNsxtPolicyLBServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,160 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lBSourceIPPersistenceProfilePurgeValues = []string{
+	model.LBSourceIpPersistenceProfile_PURGE_FULL,
+	model.LBSourceIpPersistenceProfile_PURGE_NO_PURGE,
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBSourceIPPersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBSourceIPPersistenceProfileRead,
+		Update: resourceNsxtPolicyLBSourceIPPersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBPersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                   getNsxIDSchema(),
+			"path":                     getPathSchema(),
+			"display_name":             getDisplayNameSchema(),
+			"description":              getDescriptionSchema(),
+			"revision":                 getRevisionSchema(),
+			"tag":                      getTagsSchema(),
+			"persistence_shared":       getLbPersistenceSharedSchema(),
+			"ha_persistence_mirroring": getLbHaPersistenceMirroringSchema(),
+			"timeout":                  getLbPersistenceTimeoutSchema(),
+			"purge": {
+				Type:         schema.TypeString,
+				Description:  "Persistence purge setting",
+				Optional:     true,
+				Default:      model.LBSourceIpPersistenceProfile_PURGE_FULL,
+				ValidateFunc: validation.StringInSlice(lBSourceIPPersistenceProfilePurgeValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyLBPersistenceProfileExists(id, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	haPersistenceMirroring := d.Get("ha_persistence_mirroring").(bool)
+	timeout := int64(d.Get("timeout").(int))
+	purge := d.Get("purge").(string)
+
+	obj := model.LBSourceIpPersistenceProfile{
+		DisplayName:                   &displayName,
+		Description:                   &description,
+		Tags:                          tags,
+		PersistenceShared:             &persistenceShared,
+		HaPersistenceMirroringEnabled: &haPersistenceMirroring,
+		Timeout:                       &timeout,
+		Purge:                         &purge,
+		ResourceType:                  model.LBPersistenceProfile_RESOURCE_TYPE_LBSOURCEIPPERSISTENCEPROFILE,
+	}
+
+	log.Printf("[INFO] Patching LBSourceIpPersistenceProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBSourceIpPersistenceProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBSourceIpPersistenceProfile %s", errs[0])
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBSourceIPPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBSourceIPPersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBSourceIpPersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBSourceIPPersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBSourceIpPersistenceProfile ID")
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBSourceIpPersistenceProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBSourceIpPersistenceProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBPersistenceProfile with id %s is not of type LBSourceIpPersistenceProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBSourceIpPersistenceProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("persistence_shared", profile.PersistenceShared)
+	d.Set("ha_persistence_mirroring", profile.HaPersistenceMirroringEnabled)
+	d.Set("timeout", profile.Timeout)
+	d.Set("purge", profile.Purge)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBSourceIpPersistenceProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBSourceIPPersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBSourceIpPersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBSourceIPPersistenceProfileRead(d, m)
+}
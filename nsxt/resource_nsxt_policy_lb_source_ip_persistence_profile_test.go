@@ -0,0 +1,192 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBSourceIPPersistenceProfileCreateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform created",
+	"ha_persistence_mirroring": "true",
+	"persistence_shared":       "true",
+	"purge":                    "NO_PURGE",
+	"timeout":                  "100",
+}
+
+var accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform updated",
+	"ha_persistence_mirroring": "false",
+	"persistence_shared":       "false",
+	"purge":                    "FULL",
+	"timeout":                  "200",
+}
+
+func TestAccResourceNsxtPolicyLBSourceIPPersistenceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_source_ip_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBSourceIPPersistenceProfileCheckDestroy(state, accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBSourceIPPersistenceProfileExists(accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_persistence_mirroring", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["ha_persistence_mirroring"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "purge", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["purge"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["timeout"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBSourceIPPersistenceProfileExists(accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_persistence_mirroring", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["ha_persistence_mirroring"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "purge", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["purge"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["timeout"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBSourceIPPersistenceProfileExists(accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "purge", "FULL"),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", "300"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBSourceIPPersistenceProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_source_ip_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBSourceIPPersistenceProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBSourceIPPersistenceProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBSourceIPPersistenceProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBSourceIPPersistenceProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBSourceIPPersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LBSourceIPPersistenceProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBSourceIPPersistenceProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_source_ip_persistence_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBSourceIPPersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LBSourceIPPersistenceProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBSourceIPPersistenceProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBSourceIPPersistenceProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_source_ip_persistence_profile" "test" {
+  display_name             = "%s"
+  description              = "%s"
+  ha_persistence_mirroring = %s
+  persistence_shared       = %s
+  purge                    = "%s"
+  timeout                  = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["ha_persistence_mirroring"], attrMap["persistence_shared"], attrMap["purge"], attrMap["timeout"])
+}
+
+func testAccNsxtPolicyLBSourceIPPersistenceProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_source_ip_persistence_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,249 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyLbRuleFields = []string{"display_name", "match_strategy", "phase", "action", "condition"}
+
+func resourceNsxtPolicyLBVirtualServerRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBVirtualServerRuleCreate,
+		Read:   resourceNsxtPolicyLBVirtualServerRuleRead,
+		Update: resourceNsxtPolicyLBVirtualServerRuleUpdate,
+		Delete: resourceNsxtPolicyLBVirtualServerRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyLBVirtualServerRuleImport,
+		},
+
+		Schema: getPolicyLBVirtualServerRuleSchema(),
+	}
+}
+
+func getPolicyLBVirtualServerRuleSchema() map[string]*schema.Schema {
+	// Rule attributes are shared with rule block of nsxt_policy_lb_virtual_server
+	result := getPolicyLbRuleBindingSchema().Schema
+	result["display_name"].Description = "Rule name, unique within the virtual server"
+	result["display_name"].ForceNew = true
+
+	result["virtual_server_path"] = getPolicyPathSchema(true, true, "Policy path of the virtual server this rule belongs to")
+	result["insert_before"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "Name of existing rule this rule should be placed before",
+		Optional:      true,
+		ConflictsWith: []string{"position"},
+	}
+	result["position"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Description:   "Position of this rule in the rule list of the virtual server, starting from 1",
+		Optional:      true,
+		ValidateFunc:  validation.IntAtLeast(1),
+		ConflictsWith: []string{"insert_before"},
+	}
+
+	return result
+}
+
+func policyLbRuleIndex(name string, rules []model.LBRule) int {
+	for i, rule := range rules {
+		if rule.DisplayName != nil && *rule.DisplayName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func getPolicyLbRuleFromSchema(d *schema.ResourceData) model.LBRule {
+	ruleData := make(map[string]interface{})
+	for _, field := range policyLbRuleFields {
+		ruleData[field] = d.Get(field)
+	}
+	return getPolicyLbRulesFromList([]interface{}{ruleData})[0]
+}
+
+// Place the rule in the rule list according to insert_before or position setting.
+// If neither is specified, the rule is appended to the end of the list.
+func insertPolicyLbRule(d *schema.ResourceData, rules []model.LBRule, rule model.LBRule) ([]model.LBRule, error) {
+	insertBefore := d.Get("insert_before").(string)
+	position := d.Get("position").(int)
+
+	index := len(rules)
+	if insertBefore != "" {
+		index = policyLbRuleIndex(insertBefore, rules)
+		if index < 0 {
+			return nil, fmt.Errorf("Rule %s specified in insert_before was not found on the virtual server", insertBefore)
+		}
+	} else if position > 0 && position <= len(rules) {
+		index = position - 1
+	}
+
+	result := append([]model.LBRule{}, rules[:index]...)
+	result = append(result, rule)
+	return append(result, rules[index:]...), nil
+}
+
+// Read the virtual server, apply modification to its rule list and update the
+// virtual server with revision of the read object. Update is repeated if the
+// virtual server was modified by someone else in the meantime.
+func policyLbVirtualServerRulesReadModifyWrite(m interface{}, vsPath string, modify func(rules []model.LBRule) ([]model.LBRule, error)) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewLbVirtualServersClient(connector)
+	vsID := getPolicyIDFromPath(vsPath)
+
+	doUpdate := func() error {
+		vs, err := client.Get(vsID)
+		if err != nil {
+			return err
+		}
+
+		rules, err := modify(vs.Rules)
+		if err != nil {
+			return err
+		}
+		vs.Rules = rules
+
+		_, err = client.Update(vsID, vs)
+		return err
+	}
+
+	lockPolicyParent(vsPath)
+	defer unlockPolicyParent(vsPath)
+
+	commonProviderConfig := getCommonProviderConfig(m)
+	return retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleCreate(d *schema.ResourceData, m interface{}) error {
+	vsPath := d.Get("virtual_server_path").(string)
+	name := d.Get("display_name").(string)
+	rule := getPolicyLbRuleFromSchema(d)
+
+	log.Printf("[INFO] Adding rule %s to LBVirtualServer %s", name, vsPath)
+	err := policyLbVirtualServerRulesReadModifyWrite(m, vsPath, func(rules []model.LBRule) ([]model.LBRule, error) {
+		if policyLbRuleIndex(name, rules) >= 0 {
+			return nil, errors.AlreadyExists{}
+		}
+		return insertPolicyLbRule(d, rules, rule)
+	})
+	if err != nil {
+		return handleCreateError("LBVirtualServerRule", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceNsxtPolicyLBVirtualServerRuleRead(d, m)
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewLbVirtualServersClient(connector)
+
+	name := d.Id()
+	if name == "" {
+		return fmt.Errorf("Error obtaining LBVirtualServerRule ID")
+	}
+	vsPath := d.Get("virtual_server_path").(string)
+
+	vs, err := client.Get(getPolicyIDFromPath(vsPath))
+	if err != nil {
+		return handleReadError(d, "LBVirtualServerRule", name, err)
+	}
+
+	index := policyLbRuleIndex(name, vs.Rules)
+	if index < 0 {
+		log.Printf("[DEBUG] LBVirtualServerRule %s not found on LBVirtualServer %s", name, vsPath)
+		d.SetId("")
+		return nil
+	}
+
+	ruleData := getPolicyLbRuleListFromModel([]model.LBRule{vs.Rules[index]})[0].(map[string]interface{})
+	for _, field := range policyLbRuleFields {
+		d.Set(field, ruleData[field])
+	}
+
+	// Detect order drift, if order was specified by the user
+	position := d.Get("position").(int)
+	if position > len(vs.Rules) {
+		position = len(vs.Rules)
+	}
+	if position > 0 && position != index+1 {
+		d.Set("position", index+1)
+	}
+	insertBefore := d.Get("insert_before").(string)
+	if insertBefore != "" {
+		beforeIndex := policyLbRuleIndex(insertBefore, vs.Rules)
+		if beforeIndex >= 0 && beforeIndex < index {
+			d.Set("insert_before", "")
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	name := d.Id()
+	vsPath := d.Get("virtual_server_path").(string)
+	rule := getPolicyLbRuleFromSchema(d)
+	reorder := d.HasChange("position") || d.HasChange("insert_before")
+
+	err := policyLbVirtualServerRulesReadModifyWrite(m, vsPath, func(rules []model.LBRule) ([]model.LBRule, error) {
+		index := policyLbRuleIndex(name, rules)
+		if index < 0 {
+			return nil, errors.NotFound{}
+		}
+		if !reorder {
+			rules[index] = rule
+			return rules, nil
+		}
+		rules = append(rules[:index], rules[index+1:]...)
+		return insertPolicyLbRule(d, rules, rule)
+	})
+	if err != nil {
+		return handleUpdateError("LBVirtualServerRule", name, err)
+	}
+
+	return resourceNsxtPolicyLBVirtualServerRuleRead(d, m)
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleDelete(d *schema.ResourceData, m interface{}) error {
+	name := d.Id()
+	vsPath := d.Get("virtual_server_path").(string)
+
+	err := policyLbVirtualServerRulesReadModifyWrite(m, vsPath, func(rules []model.LBRule) ([]model.LBRule, error) {
+		index := policyLbRuleIndex(name, rules)
+		if index < 0 {
+			// Rule is already gone
+			return rules, nil
+		}
+		return append(rules[:index], rules[index+1:]...), nil
+	})
+	if err != nil && !isNotFoundError(err) {
+		return handleDeleteError("LBVirtualServerRule", name, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	vsPath, err := getAncestorPathFromResourcePath(importID, "lb-virtual-servers")
+	if err != nil || len(importID) <= len(vsPath)+1 || !isPolicyPath(vsPath) {
+		return nil, fmt.Errorf("Please provide <virtual-server-path>/<rule-name> as an input")
+	}
+
+	d.SetId(strings.TrimPrefix(importID, vsPath+"/"))
+	d.Set("virtual_server_path", vsPath)
+
+	return []*schema.ResourceData{d}, nil
+}
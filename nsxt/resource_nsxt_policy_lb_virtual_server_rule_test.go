@@ -0,0 +1,211 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+func TestAccResourceNsxtPolicyLBVirtualServerRule_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_virtual_server_rule.test"
+	beforeResourceName := "nsxt_policy_lb_virtual_server_rule.before"
+	vsResourceName := "nsxt_policy_lb_virtual_server.test"
+	vsName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBVirtualServerRuleCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerRuleTemplate(vsName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerRuleExists(testResourceName),
+					testAccNsxtPolicyLBVirtualServerRuleExists(beforeResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", "terraform-rule-test"),
+					resource.TestCheckResourceAttr(testResourceName, "phase", "HTTP_FORWARDING"),
+					resource.TestCheckResourceAttr(testResourceName, "match_strategy", "ANY"),
+					resource.TestCheckResourceAttr(testResourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "action.0.http_reject.0.reply_status", "404"),
+					resource.TestCheckResourceAttr(testResourceName, "condition.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "condition.0.http_request_uri.0.uri", "/test"),
+					resource.TestCheckResourceAttr(beforeResourceName, "insert_before", "terraform-rule-test"),
+					resource.TestCheckResourceAttrSet(testResourceName, "virtual_server_path"),
+					resource.TestCheckResourceAttr(vsResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(vsResourceName, "rule.0.display_name", "inline-rule"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBVirtualServerRuleTemplate(vsName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerRuleExists(testResourceName),
+					testAccNsxtPolicyLBVirtualServerRuleExists(beforeResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", "terraform-rule-test"),
+					resource.TestCheckResourceAttr(testResourceName, "match_strategy", "ALL"),
+					resource.TestCheckResourceAttr(testResourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "action.0.http_reject.0.reply_status", "403"),
+					resource.TestCheckResourceAttr(testResourceName, "condition.0.http_request_uri.0.uri", "/updated"),
+					resource.TestCheckResourceAttr(beforeResourceName, "position", "1"),
+					resource.TestCheckResourceAttr(beforeResourceName, "insert_before", ""),
+					resource.TestCheckResourceAttr(vsResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(vsResourceName, "rule.0.display_name", "inline-rule"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBVirtualServerRule_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_virtual_server_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBVirtualServerRuleCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerRuleTemplate(getAccTestResourceName(), true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyLBVirtualServerRuleImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_lb_virtual_server_rule.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy LBVirtualServerRule resource %s not found in resources", "nsxt_policy_lb_virtual_server_rule.test")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("NSX Policy LBVirtualServerRule resource ID not set in resources")
+	}
+	vsPath := rs.Primary.Attributes["virtual_server_path"]
+	if vsPath == "" {
+		return "", fmt.Errorf("NSX Policy LBVirtualServerRule virtual_server_path not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", vsPath, resourceID), nil
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		nsxClient := infra.NewLbVirtualServersClient(connector)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBVirtualServerRule resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBVirtualServerRule resource ID not set in resources")
+		}
+
+		vsPath := rs.Primary.Attributes["virtual_server_path"]
+		vs, err := nsxClient.Get(getPolicyIDFromPath(vsPath))
+		if err != nil {
+			return fmt.Errorf("Error while retrieving policy LBVirtualServer %s. Error: %v", vsPath, err)
+		}
+		if policyLbRuleIndex(resourceID, vs.Rules) < 0 {
+			return fmt.Errorf("Policy LBVirtualServerRule %s not found on LBVirtualServer %s", resourceID, vsPath)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	nsxClient := infra.NewLbVirtualServersClient(connector)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_virtual_server_rule" {
+			continue
+		}
+
+		vsPath := rs.Primary.Attributes["virtual_server_path"]
+		vs, err := nsxClient.Get(getPolicyIDFromPath(vsPath))
+		if err == nil && policyLbRuleIndex(rs.Primary.ID, vs.Rules) >= 0 {
+			return fmt.Errorf("Policy LBVirtualServerRule %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleTemplate(vsName string, createFlow bool) string {
+	matchStrategy := "ANY"
+	replyStatus := "404"
+	uri := "/test"
+	ordering := "insert_before       = nsxt_policy_lb_virtual_server_rule.test.display_name"
+	if !createFlow {
+		matchStrategy = "ALL"
+		replyStatus = "403"
+		uri = "/updated"
+		ordering = "position            = 1"
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_app_profile" "default_http"{
+  type         = "HTTP"
+  display_name = "default-http-lb-app-profile"
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_http.path
+  ip_address               = "1.1.1.1"
+  ports                    = ["80"]
+  ignore_unmanaged_rules   = true
+
+  rule {
+    display_name = "inline-rule"
+    action {
+      connection_drop {}
+    }
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server_rule" "test" {
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+  display_name        = "terraform-rule-test"
+  match_strategy      = "%s"
+
+  action {
+    http_reject {
+      reply_status = "%s"
+    }
+  }
+
+  condition {
+    http_request_uri {
+      uri = "%s"
+    }
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server_rule" "before" {
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+  display_name        = "terraform-rule-before"
+  %s
+
+  action {
+    connection_drop {}
+  }
+}`, vsName, matchStrategy, replyStatus, uri, ordering)
+}
@@ -5,11 +5,13 @@ package nsxt
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
 var accTestPolicyLBVirtualServerCreateAttributes = map[string]string{
@@ -1147,3 +1149,87 @@ data "nsxt_policy_realization_info" "realization_info" {
   path = nsxt_policy_lb_virtual_server.test.path
 }`, attrMap["display_name"], attrMap["ip_address"])
 }
+
+func testPolicyLbRules(names ...string) []model.LBRule {
+	var rules []model.LBRule
+	for i := range names {
+		rules = append(rules, model.LBRule{DisplayName: &names[i]})
+	}
+	return rules
+}
+
+func TestMergePolicyLbRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   []string
+		owned      []string
+		ownedNames []string
+		expected   []string
+	}{
+		{
+			name:       "no existing rules",
+			owned:      []string{"a", "b"},
+			ownedNames: []string{"a", "b"},
+			expected:   []string{"a", "b"},
+		},
+		{
+			name:       "unmanaged rule keeps position",
+			existing:   []string{"a", "x", "b", "y"},
+			owned:      []string{"a", "b"},
+			ownedNames: []string{"a", "b"},
+			expected:   []string{"a", "x", "b", "y"},
+		},
+		{
+			name:       "unmanaged rule inserted first",
+			existing:   []string{"x", "a", "b"},
+			owned:      []string{"a", "b"},
+			ownedNames: []string{"a", "b"},
+			expected:   []string{"x", "a", "b"},
+		},
+		{
+			name:       "owned rules reordered",
+			existing:   []string{"a", "x", "b"},
+			owned:      []string{"b", "a"},
+			ownedNames: []string{"a", "b"},
+			expected:   []string{"b", "x", "a"},
+		},
+		{
+			name:       "new owned rule after last owned",
+			existing:   []string{"a", "x", "b", "y"},
+			owned:      []string{"a", "b", "c"},
+			ownedNames: []string{"a", "b", "c"},
+			expected:   []string{"a", "x", "b", "c", "y"},
+		},
+		{
+			name:       "owned rule removed",
+			existing:   []string{"x", "a", "y", "b"},
+			owned:      []string{"a"},
+			ownedNames: []string{"a", "b"},
+			expected:   []string{"x", "a", "y"},
+		},
+		{
+			name:       "no owned rules on NSX",
+			existing:   []string{"x", "y"},
+			owned:      []string{"a"},
+			ownedNames: []string{"a"},
+			expected:   []string{"x", "y", "a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ownedNames := make(map[string]bool)
+			for _, name := range test.ownedNames {
+				ownedNames[name] = true
+			}
+			result := mergePolicyLbRules(testPolicyLbRules(test.existing...), testPolicyLbRules(test.owned...), ownedNames)
+			var names []string
+			for _, rule := range result {
+				names = append(names, *rule.DisplayName)
+			}
+			if !reflect.DeepEqual(names, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, names)
+			}
+		})
+	}
+}
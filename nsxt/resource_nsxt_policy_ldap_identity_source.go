@@ -31,7 +31,7 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 		Update: resourceNsxtPolicyLdapIdentitySourceUpdate,
 		Delete: resourceNsxtPolicyLdapIdentitySourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -92,10 +92,11 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 							Default:     true,
 						},
 						"password": {
-							Type:        schema.TypeString,
-							Description: "The authentication password for login",
-							Optional:    true,
-							Sensitive:   true,
+							Type:             schema.TypeString,
+							Description:      "The authentication password for login",
+							Optional:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: secretDiffSuppressFunc,
 						},
 						"url": {
 							Type:         schema.TypeString,
@@ -112,6 +113,7 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 					},
 				},
 			},
+			"password_version": getSecretVersionSchema("ldap_server passwords"),
 		},
 	}
 }
@@ -119,12 +121,12 @@ func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 func getLdapServersFromSchema(d *schema.ResourceData) []nsxModel.IdentitySourceLdapServer {
 	servers := d.Get("ldap_server").([]interface{})
 	serverList := make([]nsxModel.IdentitySourceLdapServer, 0)
-	for _, server := range servers {
+	for i, server := range servers {
 		data := server.(map[string]interface{})
 		bindIdentity := data["bind_identity"].(string)
 		certificates := interface2StringList(data["certificates"].([]interface{}))
 		enabled := data["enabled"].(bool)
-		password := data["password"].(string)
+		password := getSecretFromConfig(d, "ldap_server", i, "password")
 		url := data["url"].(string)
 		useStarttls := data["use_starttls"].(bool)
 		elem := nsxModel.IdentitySourceLdapServer{
@@ -141,7 +143,7 @@ func getLdapServersFromSchema(d *schema.ResourceData) []nsxModel.IdentitySourceL
 	return serverList
 }
 
-// getLdapServerPasswordMap caches password of ldap servers for setting back to schema after read
+// getLdapServerPasswordMap caches password hash of ldap servers for setting back to schema after read
 func getLdapServerPasswordMap(d *schema.ResourceData) map[string]string {
 	passwordMap := make(map[string]string)
 	servers := d.Get("ldap_server").([]interface{})
@@ -149,7 +151,7 @@ func getLdapServerPasswordMap(d *schema.ResourceData) map[string]string {
 		data := server.(map[string]interface{})
 		password := data["password"].(string)
 		url := data["url"].(string)
-		passwordMap[url] = password
+		passwordMap[url] = getSecretHash(password)
 	}
 
 	return passwordMap
@@ -0,0 +1,271 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var metadataProxyCryptoProtocolsValues = []string{
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1,
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1_1,
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1_2,
+}
+
+func resourceNsxtPolicyMetadataProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMetadataProxyCreate,
+		Read:   resourceNsxtPolicyMetadataProxyRead,
+		Update: resourceNsxtPolicyMetadataProxyUpdate,
+		Delete: resourceNsxtPolicyMetadataProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":            getNsxIDSchema(),
+			"path":              getPathSchema(),
+			"display_name":      getDisplayNameSchema(),
+			"description":       getDescriptionSchema(),
+			"revision":          getRevisionSchema(),
+			"tag":               getTagsSchema(),
+			"edge_cluster_path": getPolicyPathSchema(true, false, "Policy path to edge cluster hosting the metadata proxy"),
+			"server_address": {
+				Type:         schema.TypeString,
+				Description:  "Metadata server URL, in http://<server-address>:<port>/ format",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+			"secret": {
+				Type:             schema.TypeString,
+				Description:      "Secret used to authenticate requests to the metadata server",
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: secretDiffSuppressFunc,
+			},
+			"secret_version": getSecretVersionSchema("secret"),
+			"crypto_protocols": {
+				Type:        schema.TypeList,
+				Description: "Cryptographic protocols for communication with metadata server over https",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(metadataProxyCryptoProtocolsValues, false),
+				},
+			},
+			"enable_standby_relocation": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable standby relocation for the metadata proxy",
+				Optional:    true,
+				Default:     false,
+			},
+			"preferred_edge_paths": {
+				Type:        schema.TypeList,
+				Description: "Preferred edge nodes for the metadata proxy, first one is assigned as active edge",
+				Optional:    true,
+				MaxItems:    2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"server_certificates": {
+				Type:        schema.TypeList,
+				Description: "Policy paths to CA certificates used to verify metadata server certificate",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyMetadataProxyExists(id string, connector client.Connector) (bool, error) {
+	client := infra.NewMetadataProxiesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyMetadataProxyPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	edgeClusterPath := d.Get("edge_cluster_path").(string)
+	serverAddress := d.Get("server_address").(string)
+	enableStandbyRelocation := d.Get("enable_standby_relocation").(bool)
+	cryptoProtocols := interface2StringList(d.Get("crypto_protocols").([]interface{}))
+	preferredEdgePaths := interface2StringList(d.Get("preferred_edge_paths").([]interface{}))
+	serverCertificates := interface2StringList(d.Get("server_certificates").([]interface{}))
+
+	obj := model.MetadataProxyConfig{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		EdgeClusterPath:         &edgeClusterPath,
+		ServerAddress:           &serverAddress,
+		EnableStandbyRelocation: &enableStandbyRelocation,
+		PreferredEdgePaths:      preferredEdgePaths,
+		ServerCertificates:      serverCertificates,
+	}
+
+	if len(cryptoProtocols) > 0 {
+		obj.CryptoProtocols = cryptoProtocols
+	}
+
+	if d.HasChange("secret") || d.HasChange("secret_version") {
+		secret := getSecretFromConfig(d, "secret")
+		obj.Secret = &secret
+	}
+
+	log.Printf("[INFO] Patching MetadataProxy with ID %s", id)
+	client := infra.NewMetadataProxiesClient(connector)
+	return client.Patch(id, obj)
+}
+
+// Metadata proxy is only usable by workloads once realized on the edge cluster
+func resourceNsxtPolicyMetadataProxyWaitForRealization(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	path := d.Get("path").(string)
+	log.Printf("[DEBUG] Waiting for realization of MetadataProxy %s", path)
+
+	stateConf := nsxtPolicyWaitForRealizationStateConf(getPolicyConnector(m), d, path, int(timeout.Seconds()))
+	entity, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Failed to realize MetadataProxy %s: %v", path, err)
+	}
+
+	realizedResource, ok := entity.(model.GenericPolicyRealizedResource)
+	if ok && realizedResource.State != nil && *realizedResource.State == model.GenericPolicyRealizedResource_STATE_ERROR {
+		return fmt.Errorf("MetadataProxy %s realized with error", path)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyMetadataProxyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyMetadataProxyExists(id, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with ID %s already exists", id)
+		}
+	}
+
+	err := resourceNsxtPolicyMetadataProxyPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("MetadataProxy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	err = resourceNsxtPolicyMetadataProxyRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtPolicyMetadataProxyWaitForRealization(d, m, d.Timeout(schema.TimeoutCreate))
+}
+
+func resourceNsxtPolicyMetadataProxyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining MetadataProxy ID")
+	}
+
+	client := infra.NewMetadataProxiesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "MetadataProxy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("edge_cluster_path", obj.EdgeClusterPath)
+	d.Set("server_address", obj.ServerAddress)
+	// NOTE: secret is not returned on API responses, only its hash is kept in state
+	setSecretHashInSchema(d, "secret")
+	d.Set("crypto_protocols", obj.CryptoProtocols)
+	d.Set("enable_standby_relocation", obj.EnableStandbyRelocation)
+	d.Set("preferred_edge_paths", obj.PreferredEdgePaths)
+	d.Set("server_certificates", obj.ServerCertificates)
+
+	return nil
+}
+
+func resourceNsxtPolicyMetadataProxyUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining MetadataProxy ID")
+	}
+
+	err := resourceNsxtPolicyMetadataProxyPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("MetadataProxy", id, err)
+	}
+
+	err = resourceNsxtPolicyMetadataProxyRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtPolicyMetadataProxyWaitForRealization(d, m, d.Timeout(schema.TimeoutUpdate))
+}
+
+func resourceNsxtPolicyMetadataProxyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining MetadataProxy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewMetadataProxiesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("MetadataProxy", id, err)
+	}
+
+	return nil
+}
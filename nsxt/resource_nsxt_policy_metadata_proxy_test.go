@@ -0,0 +1,221 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyMetadataProxyCreateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform created",
+	"server_address":            "http://192.168.1.1:3000/",
+	"secret":                    "top-secret",
+	"enable_standby_relocation": "true",
+}
+
+var accTestPolicyMetadataProxyUpdateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform updated",
+	"server_address":            "http://192.168.1.2:3001/",
+	"secret":                    "top-secret-2",
+	"enable_standby_relocation": "false",
+}
+
+func TestAccResourceNsxtPolicyMetadataProxy_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_metadata_proxy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataProxyCheckDestroy(state, accTestPolicyMetadataProxyUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMetadataProxyTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataProxyExists(accTestPolicyMetadataProxyCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyMetadataProxyCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyMetadataProxyCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_address", accTestPolicyMetadataProxyCreateAttributes["server_address"]),
+					testAccCheckResourceSecretHash(testResourceName, "secret", accTestPolicyMetadataProxyCreateAttributes["secret"]),
+					resource.TestCheckResourceAttr(testResourceName, "enable_standby_relocation", accTestPolicyMetadataProxyCreateAttributes["enable_standby_relocation"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMetadataProxyTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataProxyExists(accTestPolicyMetadataProxyUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyMetadataProxyUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyMetadataProxyUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_address", accTestPolicyMetadataProxyUpdateAttributes["server_address"]),
+					testAccCheckResourceSecretHash(testResourceName, "secret", accTestPolicyMetadataProxyUpdateAttributes["secret"]),
+					resource.TestCheckResourceAttr(testResourceName, "enable_standby_relocation", accTestPolicyMetadataProxyUpdateAttributes["enable_standby_relocation"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMetadataProxy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_metadata_proxy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataProxyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMetadataProxyTemplate(true),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMetadataProxy_withSegment(t *testing.T) {
+	testResourceName := "nsxt_policy_segment.test"
+	tzName := getOverlayTransportZoneName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataProxyCheckDestroy(state, accTestPolicyMetadataProxyCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMetadataProxySegmentTemplate(tzName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "metadata_proxy_paths.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "metadata_proxy_paths.0", "nsxt_policy_metadata_proxy.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMetadataProxySegmentTemplate(tzName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "metadata_proxy_paths.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyMetadataProxyExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy MetadataProxy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy MetadataProxy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyMetadataProxyExists(resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy MetadataProxy %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyMetadataProxyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_metadata_proxy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyMetadataProxyExists(resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy MetadataProxy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyMetadataProxyTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyMetadataProxyCreateAttributes
+	} else {
+		attrMap = accTestPolicyMetadataProxyUpdateAttributes
+	}
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+resource "nsxt_policy_metadata_proxy" "test" {
+  display_name              = "%s"
+  description               = "%s"
+  edge_cluster_path         = data.nsxt_policy_edge_cluster.test.path
+  server_address            = "%s"
+  secret                    = "%s"
+  enable_standby_relocation = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["server_address"], attrMap["secret"], attrMap["enable_standby_relocation"])
+}
+
+func testAccNsxtPolicyMetadataProxySegmentTemplate(tzName string, withProxy bool) string {
+	proxyPaths := ""
+	if withProxy {
+		proxyPaths = "metadata_proxy_paths = [nsxt_policy_metadata_proxy.test.path]"
+	}
+	return testAccNsxtPolicyMetadataProxyTemplate(true) + fmt.Sprintf(`
+data "nsxt_policy_transport_zone" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+  %s
+
+  subnet {
+    cidr = "12.12.2.1/24"
+  }
+}`, tzName, getAccTestResourceName(), proxyPaths)
+}
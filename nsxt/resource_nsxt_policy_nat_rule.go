@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +30,13 @@ var policyNATRuleActionTypeValues = []string{
 	model.PolicyNatRule_ACTION_NO_DNAT,
 	model.PolicyNatRule_ACTION_NAT64,
 }
+
+// INTERNAL section is owned by NSX, hence rules can not be managed there
+var policyNATRuleNatTypeValues = []string{
+	model.PolicyNat_NAT_TYPE_USER,
+	model.PolicyNat_NAT_TYPE_DEFAULT,
+	model.PolicyNat_NAT_TYPE_NAT64,
+}
 var policyNATRuleFirewallMatchTypeValues = []string{
 	model.PolicyNatRule_FIREWALL_MATCH_MATCH_EXTERNAL_ADDRESS,
 	model.PolicyNatRule_FIREWALL_MATCH_MATCH_INTERNAL_ADDRESS,
@@ -43,6 +52,7 @@ func resourceNsxtPolicyNATRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyNATRuleImport,
 		},
+		CustomizeDiff: resourceNsxtPolicyNATRuleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -59,6 +69,14 @@ func resourceNsxtPolicyNATRule() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(policyNATRuleActionTypeValues, false),
 			},
+			"nat_type": {
+				Type:         schema.TypeString,
+				Description:  "NAT section for the rule. If not specified, it is derived from the action",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(policyNATRuleNatTypeValues, false),
+			},
 			"destination_networks": {
 				Type:        schema.TypeList,
 				Description: "The destination network(s) for the NAT Rule",
@@ -160,8 +178,7 @@ func resourceNsxtPolicyNATRuleDelete(d *schema.ResourceData, m interface{}) erro
 		return handleMultitenancyTier0Error()
 	}
 
-	action := d.Get("action").(string)
-	natType := getNatTypeByAction(action)
+	natType := getPolicyNATRuleNatType(d)
 	err := deleteNsxtPolicyNATRule(context, getPolicyConnector(m), gwID, isT0, natType, id)
 	if err != nil {
 		return handleDeleteError("NAT Rule", id, err)
@@ -179,8 +196,7 @@ func getNsxtPolicyNATRuleByID(sessionContext utl.SessionContext, connector clien
 	return client.Get(gwID, natType, ruleID)
 }
 
-func patchNsxtPolicyNATRule(sessionContext utl.SessionContext, connector client.Connector, gwID string, rule model.PolicyNatRule, isT0 bool) error {
-	natType := getNatTypeByAction(*rule.Action)
+func patchNsxtPolicyNATRule(sessionContext utl.SessionContext, connector client.Connector, gwID string, natType string, rule model.PolicyNatRule, isT0 bool) error {
 	_, err := getTranslatedNetworks(rule)
 	if err != nil {
 		return err
@@ -201,6 +217,100 @@ func getNatTypeByAction(action string) string {
 	return model.PolicyNat_NAT_TYPE_USER
 }
 
+// NAT section is either configured explicitly, or derived from the action
+func getPolicyNATRuleNatType(d *schema.ResourceData) string {
+	natType := d.Get("nat_type").(string)
+	if natType == "" {
+		natType = getNatTypeByAction(d.Get("action").(string))
+	}
+
+	return natType
+}
+
+func validatePolicyNATRuleAction(natType string, action string) error {
+	isNat64Action := action == model.PolicyNatRule_ACTION_NAT64
+	isNat64Section := natType == model.PolicyNat_NAT_TYPE_NAT64
+	if isNat64Action != isNat64Section {
+		return fmt.Errorf("action %s is not supported in %s NAT section", action, natType)
+	}
+
+	return nil
+}
+
+func validatePolicyNATRuleImportNatType(natType string) error {
+	for _, value := range policyNATRuleNatTypeValues {
+		if natType == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("NAT rules in %s section can not be managed, expected one of %v", natType, policyNATRuleNatTypeValues)
+}
+
+func isPolicyNATRuleIPv6Network(network string) bool {
+	// network is an IP address, CIDR or range
+	address := strings.Split(strings.Split(network, "/")[0], "-")[0]
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() == nil
+}
+
+// NAT64 rules translate IPv6 source and destination into IPv4 translated networks
+func validatePolicyNAT64RuleNetworks(diff *schema.ResourceDiff) error {
+	for _, attr := range []string{"source_networks", "destination_networks", "translated_networks"} {
+		if !diff.NewValueKnown(attr) {
+			continue
+		}
+		expectIPv6 := attr != "translated_networks"
+		for _, network := range interfaceListToStringList(diff.Get(attr).([]interface{})) {
+			if network == "" || isPolicyNATRuleIPv6Network(network) == expectIPv6 {
+				continue
+			}
+			if expectIPv6 {
+				return fmt.Errorf("%s for NAT64 rule should be IPv6, got %s", attr, network)
+			}
+			return fmt.Errorf("%s for NAT64 rule should be IPv4, got %s", attr, network)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyNATRuleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("action") {
+		return nil
+	}
+	action := diff.Get("action").(string)
+	natType := diff.Get("nat_type").(string)
+
+	natTypeConfigured := false
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() {
+		natTypeConfigured = !rawConfig.GetAttr("nat_type").IsNull()
+	}
+	if natTypeConfigured && !diff.NewValueKnown("nat_type") {
+		return nil
+	}
+	if !natTypeConfigured {
+		// Changing section implied by the action requires the rule to be re-created
+		natType = getNatTypeByAction(action)
+		if !diff.NewValueKnown("nat_type") || diff.Get("nat_type").(string) != natType {
+			err := diff.SetNew("nat_type", natType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := validatePolicyNATRuleAction(natType, action)
+	if err != nil {
+		return err
+	}
+
+	if natType == model.PolicyNat_NAT_TYPE_NAT64 {
+		return validatePolicyNAT64RuleNetworks(diff)
+	}
+
+	return nil
+}
+
 func translatedNetworksNeeded(action string) bool {
 	return action != model.PolicyNatRule_ACTION_NO_SNAT && action != model.PolicyNatRule_ACTION_NO_DNAT
 }
@@ -233,8 +343,7 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 		return handleMultitenancyTier0Error()
 	}
 
-	action := d.Get("action").(string)
-	natType := getNatTypeByAction(action)
+	natType := getPolicyNATRuleNatType(d)
 	obj, err := getNsxtPolicyNATRuleByID(context, connector, gwID, isT0, natType, id)
 	if err != nil {
 		return handleReadError(d, "NAT Rule", id, err)
@@ -247,6 +356,7 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 	d.Set("action", obj.Action)
+	d.Set("nat_type", natType)
 	if obj.DestinationNetwork != nil {
 		d.Set("destination_networks", commaSeparatedStringToStringList(*obj.DestinationNetwork))
 	}
@@ -274,7 +384,7 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	action := d.Get("action").(string)
-	natType := getNatTypeByAction(action)
+	natType := getPolicyNATRuleNatType(d)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
 		return fmt.Errorf("gateway_path is not valid")
@@ -337,7 +447,7 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 
 	log.Printf("[INFO] Creating NAT Rule with ID %s", id)
 
-	err := patchNsxtPolicyNATRule(getSessionContext(d, m), connector, gwID, ruleStruct, isT0)
+	err := patchNsxtPolicyNATRule(getSessionContext(d, m), connector, gwID, natType, ruleStruct, isT0)
 	if err != nil {
 		return handleCreateError("NAT Rule", id, err)
 	}
@@ -406,7 +516,8 @@ func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	log.Printf("[INFO] Updating NAT Rule with ID %s", id)
-	err := patchNsxtPolicyNATRule(context, connector, gwID, ruleStruct, isT0)
+	natType := getPolicyNATRuleNatType(d)
+	err := patchNsxtPolicyNATRule(context, connector, gwID, natType, ruleStruct, isT0)
 	if err != nil {
 		return handleUpdateError("NAT Rule", id, err)
 	}
@@ -431,11 +542,15 @@ func resourceNsxtPolicyNATRuleImport(d *schema.ResourceData, m interface{}) ([]*
 		if err != nil {
 			return nil, err
 		}
-		if natType == model.PolicyNat_NAT_TYPE_USER {
+		if err := validatePolicyNATRuleImportNatType(natType); err != nil {
+			return nil, err
+		}
+		d.Set("nat_type", natType)
+		if natType == model.PolicyNat_NAT_TYPE_NAT64 {
 			// Value will be overwritten by resourceNsxtPolicyNATRuleRead()
-			d.Set("action", model.PolicyNatRule_ACTION_DNAT)
-		} else {
 			d.Set("action", model.PolicyNatRule_ACTION_NAT64)
+		} else {
+			d.Set("action", model.PolicyNatRule_ACTION_DNAT)
 		}
 		return rd, nil
 	} else if !errors.Is(err, ErrNotAPolicyPath) {
@@ -445,6 +560,10 @@ func resourceNsxtPolicyNATRuleImport(d *schema.ResourceData, m interface{}) ([]*
 		return nil, fmt.Errorf("Please provide <gateway-id>/<nat-rule-id>/[nat-type] as an input")
 	}
 	if len(s) == 3 {
+		if err := validatePolicyNATRuleImportNatType(s[2]); err != nil {
+			return nil, err
+		}
+		d.Set("nat_type", s[2])
 		// take care of NAT64 nat-type via action
 		if s[2] == model.PolicyNat_NAT_TYPE_NAT64 {
 			d.Set("action", model.PolicyNatRule_ACTION_NAT64)
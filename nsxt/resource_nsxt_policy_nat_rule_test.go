@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -275,6 +276,7 @@ func TestAccResourceNsxtPolicyNATRule_nat64T1(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "translated_networks.0", tnet),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "tag.#", "2"),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "action", action),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "nat_type", model.PolicyNat_NAT_TYPE_NAT64),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "logging", "false"),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "firewall_match", model.PolicyNatRule_FIREWALL_MATCH_BYPASS),
 					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRuleName, "path"),
@@ -295,6 +297,7 @@ func TestAccResourceNsxtPolicyNATRule_nat64T1(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "translated_networks.0", tnet1),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "tag.#", "2"),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "action", action),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "nat_type", model.PolicyNat_NAT_TYPE_NAT64),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "logging", "false"),
 					resource.TestCheckResourceAttr(testAccResourcePolicyNATRuleName, "firewall_match", model.PolicyNatRule_FIREWALL_MATCH_BYPASS),
 					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRuleName, "path"),
@@ -305,6 +308,25 @@ func TestAccResourceNsxtPolicyNATRule_nat64T1(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyNATRule_nat64Validation(t *testing.T) {
+	name := getAccTestResourceName()
+	snet := "22.1.1.2"
+	dnet := "2001:db8:122:344::/96"
+	tnet := "44.1.1.2"
+	action := model.PolicyNatRule_ACTION_NAT64
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyNATRuleTier1CreateTemplate(name, action, snet, dnet, tnet, false),
+				ExpectError: regexp.MustCompile("should be IPv6"),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyNATRuleNoSnatWithoutTNet(t *testing.T) {
 	name := getAccTestResourceName()
 	updateName := getAccTestResourceName()
@@ -598,3 +620,61 @@ func testAccNsxPolicyNatRuleNoTranslatedNetworkTemplate(name string, action stri
 	}
 	`, name, action, sourceNet, destNet, model.PolicyNatRule_FIREWALL_MATCH_MATCH_EXTERNAL_ADDRESS)
 }
+
+func TestValidatePolicyNATRuleAction(t *testing.T) {
+	sections := []string{
+		model.PolicyNat_NAT_TYPE_USER,
+		model.PolicyNat_NAT_TYPE_DEFAULT,
+		model.PolicyNat_NAT_TYPE_NAT64,
+	}
+
+	for _, section := range sections {
+		for _, action := range policyNATRuleActionTypeValues {
+			// NAT64 action belongs to NAT64 section only, and NAT64 section only allows NAT64 action
+			expectValid := (action == model.PolicyNatRule_ACTION_NAT64) == (section == model.PolicyNat_NAT_TYPE_NAT64)
+			err := validatePolicyNATRuleAction(section, action)
+			if expectValid && err != nil {
+				t.Errorf("expected action %s to be valid in %s section, got: %v", action, section, err)
+			}
+			if !expectValid && err == nil {
+				t.Errorf("expected action %s to be rejected in %s section", action, section)
+			}
+		}
+	}
+}
+
+func TestValidatePolicyNATRuleImportNatType(t *testing.T) {
+	for _, natType := range policyNATRuleNatTypeValues {
+		if err := validatePolicyNATRuleImportNatType(natType); err != nil {
+			t.Errorf("unexpected error for %s section: %v", natType, err)
+		}
+	}
+	for _, natType := range []string{model.PolicyNat_NAT_TYPE_INTERNAL, "user", ""} {
+		if err := validatePolicyNATRuleImportNatType(natType); err == nil {
+			t.Errorf("expected error for %s section", natType)
+		}
+	}
+}
+
+func TestIsPolicyNATRuleIPv6Network(t *testing.T) {
+	tests := []struct {
+		network  string
+		expected bool
+	}{
+		{network: "2001:db8::1", expected: true},
+		{network: "2001:db8::/64", expected: true},
+		{network: "2001:db8::1-2001:db8::10", expected: true},
+		{network: "::ffff:10.0.0.1", expected: false},
+		{network: "10.0.0.1", expected: false},
+		{network: "10.0.0.0/24", expected: false},
+		{network: "10.0.0.1-10.0.0.10", expected: false},
+		{network: "", expected: false},
+		{network: "not-an-ip", expected: false},
+	}
+
+	for _, test := range tests {
+		if result := isPolicyNATRuleIPv6Network(test.network); result != test.expected {
+			t.Errorf("isPolicyNATRuleIPv6Network(%q): expected %v, got %v", test.network, test.expected, result)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -247,6 +248,18 @@ func resourceNsxtPolicyOspfAreaDelete(d *schema.ResourceData, m interface{}) err
 
 func resourceNsxtPolicyOspfAreaImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err == nil {
+		ospfPath, err := getParameterFromPolicyPath("", "/areas/", importID)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("ospf_path", ospfPath)
+		return rd, nil
+	} else if !errors.Is(err, ErrNotAPolicyPath) {
+		return rd, err
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
 		return nil, fmt.Errorf("Please provide <tier0-id>/<locale-service-id>/<area-id> as an input")
@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +20,9 @@ func resourceNsxtPolicyOspfConfig() *schema.Resource {
 		Read:   resourceNsxtPolicyOspfConfigRead,
 		Update: resourceNsxtPolicyOspfConfigUpdate,
 		Delete: resourceNsxtPolicyOspfConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyOspfConfigImport,
+		},
 
 		Schema: getPolicyOspfConfigSchema(),
 	}
@@ -227,3 +231,24 @@ func resourceNsxtPolicyOspfConfigDelete(d *schema.ResourceData, m interface{}) e
 
 	return nil
 }
+
+func resourceNsxtPolicyOspfConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	err := fmt.Errorf("Expected OSPF config path, got %s", importID)
+	// Path should be like /infra/tier-0s/aaa/locale-services/default/ospf
+	if !isPolicyPath(importID) || !strings.HasSuffix(importID, "/ospf") {
+		return nil, err
+	}
+	isT0, gwID, localeServiceID, parseErr := parseLocaleServicePolicyPath(strings.TrimSuffix(importID, "/ospf"))
+	if parseErr != nil || !isT0 {
+		return nil, err
+	}
+	gwPath, _ := getAncestorPathFromResourcePath(importID, "tier-0s")
+
+	d.Set("gateway_path", gwPath)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+	d.SetId(newUUID())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,37 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtPolicyParentGatewayPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyParentGatewayPolicyCreate,
+		Read:   resourceNsxtPolicyParentGatewayPolicyRead,
+		Update: resourceNsxtPolicyParentGatewayPolicyUpdate,
+		Delete: resourceNsxtPolicyParentGatewayPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		Schema: getPolicyGatewayPolicySchema(false),
+	}
+}
+
+func resourceNsxtPolicyParentGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGatewayPolicyGeneralCreate(d, m, false)
+}
+
+func resourceNsxtPolicyParentGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGatewayPolicyGeneralRead(d, m, false)
+}
+
+func resourceNsxtPolicyParentGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGatewayPolicyGeneralUpdate(d, m, false)
+}
+
+func resourceNsxtPolicyParentGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGatewayPolicyDelete(d, m)
+}
@@ -0,0 +1,165 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyPimProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyPimProfileCreate,
+		Read:   resourceNsxtPolicyPimProfileRead,
+		Update: resourceNsxtPolicyPimProfileUpdate,
+		Delete: resourceNsxtPolicyPimProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"bsm_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Bootstrap messaging",
+				Optional:    true,
+				Default:     true,
+			},
+			"rp_address": {
+				Type:         schema.TypeString,
+				Description:  "Static Rendezvous Point address",
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyPimProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewPimProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyPimProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	bsmEnabled := d.Get("bsm_enabled").(bool)
+	rpAddress := d.Get("rp_address").(string)
+
+	obj := model.PolicyPimProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		BsmEnabled:  &bsmEnabled,
+	}
+	if rpAddress != "" {
+		obj.RpAddress = &rpAddress
+	}
+
+	log.Printf("[INFO] Patching PimProfile with ID %s", id)
+	client := infra.NewPimProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyPimProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyPimProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyPimProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("PimProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	client := infra.NewPimProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "PimProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("bsm_enabled", obj.BsmEnabled)
+	d.Set("rp_address", obj.RpAddress)
+
+	return nil
+}
+
+func resourceNsxtPolicyPimProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	err := resourceNsxtPolicyPimProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("PimProfile", id, err)
+	}
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewPimProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("PimProfile", id, err)
+	}
+
+	return nil
+}
@@ -81,10 +81,19 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, id
 		}
 	}
 
+	// Rules of this policy might be modified concurrently by rule resources
+	policyPath := getPolicySecurityPolicyLockPath(getSessionContext(d, m), domain, id)
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
 	log.Printf("[INFO] Using selective H-API for policy with ID %s", id)
 	return securityPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
 }
 
+func getPolicySecurityPolicyLockPath(context utl.SessionContext, domain string, id string) string {
+	return fmt.Sprintf("%s/domains/%s/security-policies/%s", getPolicyInfraPath(context), domain, id)
+}
+
 func resourceNsxtPolicySecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
 	return resourceNsxtPolicySecurityPolicyGeneralCreate(d, m, true)
 }
@@ -105,8 +114,13 @@ func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{
 
 	connector := getPolicyConnector(m)
 
+	domain := d.Get("domain").(string)
+	policyPath := getPolicySecurityPolicyLockPath(getSessionContext(d, m), domain, id)
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
 	client := domains.NewSecurityPoliciesClient(getSessionContext(d, m), connector)
-	err := client.Delete(d.Get("domain").(string), id)
+	err := client.Delete(domain, id)
 
 	if err != nil {
 		return handleDeleteError("Security Policy", id, err)
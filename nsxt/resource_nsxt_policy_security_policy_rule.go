@@ -48,6 +48,10 @@ func resourceNsxtPolicySecurityPolicyRuleCreate(d *schema.ResourceData, m interf
 	}
 
 	log.Printf("[INFO] Creating Security Policy Rule with ID %s under policy %s", id, policyPath)
+	// Rules of the same policy are serialized, since NSX updates the parent policy
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
 	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
 	rule := securityPolicyRuleSchemaToModel(d, id)
 	err = client.Patch(domain, policyID, id, rule)
@@ -214,6 +218,9 @@ func resourceNsxtPolicySecurityPolicyRuleUpdate(d *schema.ResourceData, m interf
 	domain := getDomainFromResourcePath(policyPath)
 	policyID := getPolicyIDFromPath(policyPath)
 
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
 	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
 	rule := securityPolicyRuleSchemaToModel(d, id)
 	err := client.Patch(domain, policyID, id, rule)
@@ -237,6 +244,9 @@ func resourceNsxtPolicySecurityPolicyRuleDelete(d *schema.ResourceData, m interf
 	domain := getDomainFromResourcePath(policyPath)
 	policyID := getPolicyIDFromPath(policyPath)
 
+	lockPolicyParent(policyPath)
+	defer unlockPolicyParent(policyPath)
+
 	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
 	return client.Delete(domain, policyID, id)
 }
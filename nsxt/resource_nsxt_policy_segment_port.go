@@ -0,0 +1,639 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/segments"
+	"github.com/vmware/terraform-provider-nsxt/api/infra/segments/ports"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var segmentPortAdminStateValues = []string{
+	model.SegmentPort_ADMIN_STATE_UP,
+	model.SegmentPort_ADMIN_STATE_DOWN,
+}
+
+var segmentPortAttachmentTypeValues = []string{
+	model.PortAttachment_TYPE_PARENT,
+	model.PortAttachment_TYPE_CHILD,
+	model.PortAttachment_TYPE_INDEPENDENT,
+	model.PortAttachment_TYPE_STATIC,
+}
+
+var segmentPortAllocateAddressesValues = []string{
+	model.PortAttachment_ALLOCATE_ADDRESSES_IP_POOL,
+	model.PortAttachment_ALLOCATE_ADDRESSES_MAC_POOL,
+	model.PortAttachment_ALLOCATE_ADDRESSES_BOTH,
+	model.PortAttachment_ALLOCATE_ADDRESSES_NONE,
+	model.PortAttachment_ALLOCATE_ADDRESSES_DHCP,
+	model.PortAttachment_ALLOCATE_ADDRESSES_DHCPV6,
+	model.PortAttachment_ALLOCATE_ADDRESSES_SLAAC,
+}
+
+var segmentPortHyperbusModeValues = []string{
+	model.PortAttachment_HYPERBUS_MODE_ENABLE,
+	model.PortAttachment_HYPERBUS_MODE_DISABLE,
+}
+
+func resourceNsxtPolicySegmentPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySegmentPortCreate,
+		Read:   resourceNsxtPolicySegmentPortRead,
+		Update: resourceNsxtPolicySegmentPortUpdate,
+		Delete: resourceNsxtPolicySegmentPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicySegmentPortImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(),
+			"segment_path": getPolicyPathSchema(true, true, "Policy path of the parent segment"),
+			"admin_state": {
+				Type:         schema.TypeString,
+				Description:  "Administrative state of the port",
+				Optional:     true,
+				Default:      model.SegmentPort_ADMIN_STATE_UP,
+				ValidateFunc: validation.StringInSlice(segmentPortAdminStateValues, false),
+			},
+			"address_binding": {
+				Type:        schema.TypeList,
+				Description: "Static address bindings for the port, used by SpoofGuard",
+				Optional:    true,
+				Elem:        getPolicySegmentPortAddressBindingSchema(),
+			},
+			"attachment": {
+				Type:        schema.TypeList,
+				Description: "VIF attachment of the port",
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        getPolicySegmentPortAttachmentSchema(),
+			},
+			"discovery_profile": {
+				Type:        schema.TypeList,
+				Description: "IP and MAC discovery profiles for this port",
+				Elem:        getPolicySegmentDiscoveryProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+			"qos_profile": {
+				Type:        schema.TypeList,
+				Description: "QoS profiles for this port",
+				Elem:        getPolicySegmentQosProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+			"security_profile": {
+				Type:        schema.TypeList,
+				Description: "Security profiles for this port",
+				Elem:        getPolicySegmentSecurityProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+		},
+	}
+}
+
+func getPolicySegmentPortAddressBindingSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "IP address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"mac_address": {
+				Type:         schema.TypeString,
+				Description:  "MAC address",
+				Optional:     true,
+				ValidateFunc: validation.IsMACAddress,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Description:  "VLAN ID",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+		},
+	}
+}
+
+func getPolicySegmentPortAttachmentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "VIF UUID in NSX",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "Type of port attachment",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(segmentPortAttachmentTypeValues, false),
+			},
+			"context_id": {
+				Type:        schema.TypeString,
+				Description: "Parent VIF ID for CHILD attachment, or transport node ID for INDEPENDENT attachment",
+				Optional:    true,
+			},
+			"traffic_tag": {
+				Type:         schema.TypeInt,
+				Description:  "VLAN ID to tag traffic of CHILD attachment",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			"app_id": {
+				Type:        schema.TypeString,
+				Description: "ID used to identify the application, such as container, on CHILD attachment",
+				Optional:    true,
+			},
+			"allocate_addresses": {
+				Type:         schema.TypeString,
+				Description:  "Indicates how addresses are allocated to the port",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(segmentPortAllocateAddressesValues, false),
+			},
+			"hyperbus_mode": {
+				Type:         schema.TypeString,
+				Description:  "Hyperbus mode for CHILD attachment",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(segmentPortHyperbusModeValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicySegmentPortExists(sessionContext utl.SessionContext, segmentID string, id string, connector client.Connector) (bool, error) {
+	client := segments.NewPortsClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(segmentID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+// Ports of fixed segments (segments under a Tier1 gateway) are not supported
+func getPolicySegmentPortSegmentID(d *schema.ResourceData) (string, error) {
+	segmentPath := d.Get("segment_path").(string)
+	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	if segmentID == "" {
+		return "", fmt.Errorf("segment_path %s is not a valid segment path", segmentPath)
+	}
+	if gwID != "" {
+		return "", fmt.Errorf("segment_path %s belongs to gateway %s, ports of fixed segments are not supported", segmentPath, gwID)
+	}
+
+	return segmentID, nil
+}
+
+func getPolicySegmentPortAddressBindingsFromSchema(d *schema.ResourceData) []model.PortAddressBindingEntry {
+	// Empty list rather than nil is returned, so that removed bindings are cleared on NSX
+	bindings := []model.PortAddressBindingEntry{}
+	for _, item := range d.Get("address_binding").([]interface{}) {
+		data := item.(map[string]interface{})
+		ipAddress := data["ip_address"].(string)
+		macAddress := data["mac_address"].(string)
+		vlanID := int64(data["vlan_id"].(int))
+		binding := model.PortAddressBindingEntry{
+			VlanId: &vlanID,
+		}
+		if ipAddress != "" {
+			binding.IpAddress = &ipAddress
+		}
+		if macAddress != "" {
+			binding.MacAddress = &macAddress
+		}
+		bindings = append(bindings, binding)
+	}
+
+	return bindings
+}
+
+func setPolicySegmentPortAddressBindingsInSchema(d *schema.ResourceData, bindings []model.PortAddressBindingEntry) {
+	var bindingList []map[string]interface{}
+	for _, binding := range bindings {
+		data := make(map[string]interface{})
+		data["ip_address"] = binding.IpAddress
+		data["mac_address"] = binding.MacAddress
+		data["vlan_id"] = binding.VlanId
+		bindingList = append(bindingList, data)
+	}
+
+	d.Set("address_binding", bindingList)
+}
+
+func getPolicySegmentPortAttachmentFromSchema(d *schema.ResourceData) *model.PortAttachment {
+	attachments := d.Get("attachment").([]interface{})
+	if len(attachments) == 0 || attachments[0] == nil {
+		return nil
+	}
+
+	data := attachments[0].(map[string]interface{})
+	attachment := model.PortAttachment{}
+	attachmentID := data["id"].(string)
+	if attachmentID != "" {
+		attachment.Id = &attachmentID
+	}
+	attachmentType := data["type"].(string)
+	if attachmentType != "" {
+		attachment.Type_ = &attachmentType
+	}
+	contextID := data["context_id"].(string)
+	if contextID != "" {
+		attachment.ContextId = &contextID
+	}
+	trafficTag := int64(data["traffic_tag"].(int))
+	if trafficTag > 0 {
+		attachment.TrafficTag = &trafficTag
+	}
+	appID := data["app_id"].(string)
+	if appID != "" {
+		attachment.AppId = &appID
+	}
+	allocateAddresses := data["allocate_addresses"].(string)
+	if allocateAddresses != "" {
+		attachment.AllocateAddresses = &allocateAddresses
+	}
+	hyperbusMode := data["hyperbus_mode"].(string)
+	if hyperbusMode != "" {
+		attachment.HyperbusMode = &hyperbusMode
+	}
+
+	return &attachment
+}
+
+func setPolicySegmentPortAttachmentInSchema(d *schema.ResourceData, attachment *model.PortAttachment) {
+	if attachment == nil {
+		d.Set("attachment", nil)
+		return
+	}
+
+	data := make(map[string]interface{})
+	data["id"] = attachment.Id
+	data["type"] = attachment.Type_
+	data["context_id"] = attachment.ContextId
+	data["traffic_tag"] = attachment.TrafficTag
+	data["app_id"] = attachment.AppId
+	data["allocate_addresses"] = attachment.AllocateAddresses
+	data["hyperbus_mode"] = attachment.HyperbusMode
+
+	d.Set("attachment", []interface{}{data})
+}
+
+// getPolicySegmentPortProfileMapChange returns profile configuration to apply, binding map ID,
+// and whether binding map should be removed
+func getPolicySegmentPortProfileMapChange(d *schema.ResourceData, attrName string) (map[string]interface{}, string, bool) {
+	mapID := "default"
+	oldProfiles, newProfiles := d.GetChange(attrName)
+	profiles := newProfiles.([]interface{})
+	shouldDelete := false
+	if len(profiles) == 0 || profiles[0] == nil {
+		profiles = oldProfiles.([]interface{})
+		shouldDelete = true
+	}
+	if len(profiles) == 0 || profiles[0] == nil {
+		return nil, mapID, false
+	}
+
+	profileMap := profiles[0].(map[string]interface{})
+	bindingMapPath := profileMap["binding_map_path"].(string)
+	if bindingMapPath != "" {
+		mapID = getPolicyIDFromPath(bindingMapPath)
+	}
+
+	return profileMap, mapID, shouldDelete
+}
+
+func nsxtPolicySegmentPortProfilesSet(d *schema.ResourceData, m interface{}, segmentID string, portID string) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	if d.HasChange("discovery_profile") {
+		profileMap, mapID, shouldDelete := getPolicySegmentPortProfileMapChange(d, "discovery_profile")
+		client := ports.NewPortDiscoveryProfileBindingMapsClient(context, connector)
+		if shouldDelete {
+			err := client.Delete(segmentID, portID, mapID)
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		} else if profileMap != nil {
+			obj := model.PortDiscoveryProfileBindingMap{}
+			ipDiscoveryProfilePath := profileMap["ip_discovery_profile_path"].(string)
+			if ipDiscoveryProfilePath != "" {
+				obj.IpDiscoveryProfilePath = &ipDiscoveryProfilePath
+			}
+			macDiscoveryProfilePath := profileMap["mac_discovery_profile_path"].(string)
+			if macDiscoveryProfilePath != "" {
+				obj.MacDiscoveryProfilePath = &macDiscoveryProfilePath
+			}
+			log.Printf("[INFO] Patching discovery profile binding map for port %s", portID)
+			err := client.Patch(segmentID, portID, mapID, obj)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("qos_profile") {
+		profileMap, mapID, shouldDelete := getPolicySegmentPortProfileMapChange(d, "qos_profile")
+		client := ports.NewPortQosProfileBindingMapsClient(context, connector)
+		if shouldDelete {
+			err := client.Delete(segmentID, portID, mapID)
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		} else if profileMap != nil {
+			qosProfilePath := profileMap["qos_profile_path"].(string)
+			obj := model.PortQosProfileBindingMap{
+				QosProfilePath: &qosProfilePath,
+			}
+			log.Printf("[INFO] Patching QoS profile binding map for port %s", portID)
+			err := client.Patch(segmentID, portID, mapID, obj)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("security_profile") {
+		profileMap, mapID, shouldDelete := getPolicySegmentPortProfileMapChange(d, "security_profile")
+		client := ports.NewPortSecurityProfileBindingMapsClient(context, connector)
+		if shouldDelete {
+			err := client.Delete(segmentID, portID, mapID)
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		} else if profileMap != nil {
+			obj := model.PortSecurityProfileBindingMap{}
+			spoofguardProfilePath := profileMap["spoofguard_profile_path"].(string)
+			if spoofguardProfilePath != "" {
+				obj.SpoofguardProfilePath = &spoofguardProfilePath
+			}
+			securityProfilePath := profileMap["security_profile_path"].(string)
+			if securityProfilePath != "" {
+				obj.SegmentSecurityProfilePath = &securityProfilePath
+			}
+			log.Printf("[INFO] Patching security profile binding map for port %s", portID)
+			err := client.Patch(segmentID, portID, mapID, obj)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func nsxtPolicySegmentPortProfilesRead(d *schema.ResourceData, m interface{}, segmentID string, portID string) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	discoveryClient := ports.NewPortDiscoveryProfileBindingMapsClient(context, connector)
+	discoveryMaps, err := discoveryClient.List(segmentID, portID, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to read Discovery Profile Map for port %s: %s", portID, err)
+	}
+	var discoveryConfigList []map[string]interface{}
+	for _, obj := range discoveryMaps.Results {
+		config := make(map[string]interface{})
+		config["ip_discovery_profile_path"] = obj.IpDiscoveryProfilePath
+		config["mac_discovery_profile_path"] = obj.MacDiscoveryProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		discoveryConfigList = append(discoveryConfigList, config)
+		break
+	}
+	d.Set("discovery_profile", discoveryConfigList)
+
+	qosClient := ports.NewPortQosProfileBindingMapsClient(context, connector)
+	qosMaps, err := qosClient.List(segmentID, portID, nil, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to read QoS Profile Map for port %s: %s", portID, err)
+	}
+	var qosConfigList []map[string]interface{}
+	for _, obj := range qosMaps.Results {
+		if obj.QosProfilePath == nil || len(*obj.QosProfilePath) == 0 {
+			continue
+		}
+		config := make(map[string]interface{})
+		config["qos_profile_path"] = obj.QosProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		qosConfigList = append(qosConfigList, config)
+		break
+	}
+	d.Set("qos_profile", qosConfigList)
+
+	securityClient := ports.NewPortSecurityProfileBindingMapsClient(context, connector)
+	securityMaps, err := securityClient.List(segmentID, portID, nil, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to read Security Profile Map for port %s: %s", portID, err)
+	}
+	var securityConfigList []map[string]interface{}
+	for _, obj := range securityMaps.Results {
+		config := make(map[string]interface{})
+		config["security_profile_path"] = obj.SegmentSecurityProfilePath
+		config["spoofguard_profile_path"] = obj.SpoofguardProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		securityConfigList = append(securityConfigList, config)
+		break
+	}
+	d.Set("security_profile", securityConfigList)
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentPortPatch(d *schema.ResourceData, m interface{}, segmentID string, id string, isCreate bool) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	adminState := d.Get("admin_state").(string)
+
+	obj := model.SegmentPort{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		AdminState:      &adminState,
+		AddressBindings: getPolicySegmentPortAddressBindingsFromSchema(d),
+		Attachment:      getPolicySegmentPortAttachmentFromSchema(d),
+	}
+
+	client := segments.NewPortsClient(getSessionContext(d, m), connector)
+	var err error
+	if isCreate {
+		log.Printf("[INFO] Patching SegmentPort with ID %s", id)
+		err = client.Patch(segmentID, id, obj)
+	} else {
+		// Attachment can not be removed with PATCH, hence the port is replaced as a whole
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+		log.Printf("[INFO] Updating SegmentPort with ID %s", id)
+		_, err = client.Update(segmentID, id, obj)
+	}
+	if err != nil {
+		return err
+	}
+	if isCreate {
+		// Port exists on NSX at this point, hence it should be tracked in state
+		// (and marked as tainted) even if profile binding fails
+		d.SetId(id)
+		d.Set("nsx_id", id)
+	}
+
+	return nsxtPolicySegmentPortProfilesSet(d, m, segmentID, id)
+}
+
+func resourceNsxtPolicySegmentPortCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentID, err := getPolicySegmentPortSegmentID(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicySegmentPortExists(getSessionContext(d, m), segmentID, id, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with ID %s already exists", id)
+		}
+	}
+
+	err = resourceNsxtPolicySegmentPortPatch(d, m, segmentID, id, true)
+	if err != nil {
+		return handleCreateError("SegmentPort", id, err)
+	}
+
+	return resourceNsxtPolicySegmentPortRead(d, m)
+}
+
+func resourceNsxtPolicySegmentPortRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SegmentPort ID")
+	}
+
+	segmentID, err := getPolicySegmentPortSegmentID(d)
+	if err != nil {
+		return err
+	}
+
+	client := segments.NewPortsClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(segmentID, id)
+	if err != nil {
+		return handleReadError(d, "SegmentPort", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("admin_state", obj.AdminState)
+	setPolicySegmentPortAddressBindingsInSchema(d, obj.AddressBindings)
+	setPolicySegmentPortAttachmentInSchema(d, obj.Attachment)
+
+	return nsxtPolicySegmentPortProfilesRead(d, m, segmentID, id)
+}
+
+func resourceNsxtPolicySegmentPortUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SegmentPort ID")
+	}
+
+	segmentID, err := getPolicySegmentPortSegmentID(d)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicySegmentPortPatch(d, m, segmentID, id, false)
+	if err != nil {
+		return handleUpdateError("SegmentPort", id, err)
+	}
+
+	return resourceNsxtPolicySegmentPortRead(d, m)
+}
+
+func resourceNsxtPolicySegmentPortDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SegmentPort ID")
+	}
+
+	segmentID, err := getPolicySegmentPortSegmentID(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	client := segments.NewPortsClient(getSessionContext(d, m), connector)
+	err = client.Delete(segmentID, id)
+	if err != nil {
+		return handleDeleteError("SegmentPort", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentPortImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return rd, fmt.Errorf("Segment port should be imported by policy path, got %s", importID)
+	}
+
+	segmentPath, err := getParameterFromPolicyPath("", "/ports/", importID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("segment_path", segmentPath)
+
+	return rd, nil
+}
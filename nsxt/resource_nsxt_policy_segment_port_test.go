@@ -0,0 +1,317 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySegmentPortCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"admin_state":  "UP",
+	"ip_address":   "12.12.2.10",
+	"mac_address":  "00:50:56:00:00:01",
+	"vif_id":       newUUID(),
+}
+
+var accTestPolicySegmentPortUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"admin_state":  "DOWN",
+	"ip_address":   "12.12.2.11",
+	"mac_address":  "00:50:56:00:00:02",
+	"vif_id":       newUUID(),
+}
+
+func TestAccResourceNsxtPolicySegmentPort_basic(t *testing.T) {
+	testAccResourceNsxtPolicySegmentPortBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentPort_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicySegmentPortBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicySegmentPortBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_segment_port.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, accTestPolicySegmentPortUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(true, withContext, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(accTestPolicySegmentPortCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySegmentPortCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySegmentPortCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", accTestPolicySegmentPortCreateAttributes["admin_state"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.ip_address", accTestPolicySegmentPortCreateAttributes["ip_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.mac_address", accTestPolicySegmentPortCreateAttributes["mac_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.0.id", accTestPolicySegmentPortCreateAttributes["vif_id"]),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.0.type", "STATIC"),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "security_profile.0.spoofguard_profile_path"),
+					resource.TestCheckResourceAttr(testResourceName, "discovery_profile.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "discovery_profile.0.ip_discovery_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "discovery_profile.0.mac_discovery_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "segment_path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(false, withContext, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(accTestPolicySegmentPortUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySegmentPortUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySegmentPortUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", accTestPolicySegmentPortUpdateAttributes["admin_state"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.ip_address", accTestPolicySegmentPortUpdateAttributes["ip_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.mac_address", accTestPolicySegmentPortUpdateAttributes["mac_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.0.id", accTestPolicySegmentPortUpdateAttributes["vif_id"]),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "discovery_profile.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(false, withContext, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(accTestPolicySegmentPortUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "discovery_profile.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentPort_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_segment_port.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(true, false, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentPortExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy SegmentPort resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy SegmentPort resource ID not set in resources")
+		}
+
+		segmentID := getPolicyIDFromPath(rs.Primary.Attributes["segment_path"])
+		exists, err := resourceNsxtPolicySegmentPortExists(testAccGetSessionContext(), segmentID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy SegmentPort %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySegmentPortCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_segment_port" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		segmentID := getPolicyIDFromPath(rs.Primary.Attributes["segment_path"])
+		exists, err := resourceNsxtPolicySegmentPortExists(testAccGetSessionContext(), segmentID, resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy SegmentPort %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySegmentPortDeps(withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_spoofguard_profile" "test" {
+%s
+  display_name = "default-spoofguard-profile"
+}
+
+data "nsxt_policy_ip_discovery_profile" "test" {
+%s
+  display_name = "default-ip-discovery-profile"
+}
+
+data "nsxt_policy_mac_discovery_profile" "test" {
+%s
+  display_name = "default-mac-discovery-profile"
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+%s
+  display_name = "terraform-port-test"
+}
+
+resource "nsxt_policy_segment" "test" {
+%s
+  display_name      = "terraform-port-test"
+  connectivity_path = nsxt_policy_tier1_gateway.test.path
+
+  subnet {
+    cidr = "12.12.2.1/24"
+  }
+}
+`, context, context, context, context, context)
+}
+
+func testAccNsxtPolicySegmentPortTemplate(createFlow bool, withContext bool, withProfiles bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySegmentPortCreateAttributes
+	} else {
+		attrMap = accTestPolicySegmentPortUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	profiles := ""
+	if withProfiles {
+		profiles = `
+  security_profile {
+    spoofguard_profile_path = data.nsxt_policy_spoofguard_profile.test.path
+  }
+
+  discovery_profile {
+    ip_discovery_profile_path  = data.nsxt_policy_ip_discovery_profile.test.path
+    mac_discovery_profile_path = data.nsxt_policy_mac_discovery_profile.test.path
+  }`
+	}
+	return testAccNsxtPolicySegmentPortDeps(withContext) + fmt.Sprintf(`
+resource "nsxt_policy_segment_port" "test" {
+%s
+  display_name = "%s"
+  description  = "%s"
+  segment_path = nsxt_policy_segment.test.path
+  admin_state  = "%s"
+
+  address_binding {
+    ip_address  = "%s"
+    mac_address = "%s"
+  }
+
+  attachment {
+    id   = "%s"
+    type = "STATIC"
+  }
+%s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["admin_state"], attrMap["ip_address"], attrMap["mac_address"], attrMap["vif_id"], profiles)
+}
+
+func TestGetPolicySegmentPortSegmentID(t *testing.T) {
+	tests := []struct {
+		segmentPath string
+		segmentID   string
+		expectErr   bool
+	}{
+		{segmentPath: "/infra/segments/seg1", segmentID: "seg1"},
+		{segmentPath: "/orgs/default/projects/dev/infra/segments/seg1", segmentID: "seg1"},
+		{segmentPath: "/infra/tier-1s/gw1/segments/seg1", expectErr: true},
+		{segmentPath: "/infra/tier-1s/gw1", expectErr: true},
+	}
+
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourceNsxtPolicySegmentPort().Schema, map[string]interface{}{
+			"segment_path": test.segmentPath,
+		})
+		segmentID, err := getPolicySegmentPortSegmentID(d)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("expected error for segment path %s", test.segmentPath)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for segment path %s: %v", test.segmentPath, err)
+		}
+		if segmentID != test.segmentID {
+			t.Errorf("expected segment ID %s, got %s", test.segmentID, segmentID)
+		}
+	}
+}
+
+func TestGetPolicySegmentPortAddressBindingsFromSchema(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicySegmentPort().Schema, map[string]interface{}{
+		"segment_path": "/infra/segments/seg1",
+	})
+	// Bindings should be cleared on NSX when removed from configuration
+	bindings := getPolicySegmentPortAddressBindingsFromSchema(d)
+	if bindings == nil || len(bindings) != 0 {
+		t.Errorf("expected empty non-nil bindings, got %v", bindings)
+	}
+}
@@ -0,0 +1,147 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicySelfSignedCertificate() *schema.Resource {
+	s := addCertificateDetailsSchema(getCertificateKeySchema())
+	s["nsx_id"] = getComputedNsxIDSchema()
+	s["path"] = getPathSchema()
+	s["display_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Display name for this resource",
+		Required:    true,
+		ForceNew:    true,
+	}
+	s["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Description for this resource",
+		Optional:    true,
+		ForceNew:    true,
+	}
+	s["revision"] = getRevisionSchema()
+	s["tag"] = getTagsSchemaForceNew()
+	s["days_valid"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Number of days the certificate will be valid. For non-CA certificates, validity is limited to 825 days by NSX",
+		Optional:     true,
+		ForceNew:     true,
+		Default:      825,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	s["pem_encoded"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "PEM encoded certificate",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicySelfSignedCertificateCreate,
+		Read:   resourceNsxtPolicySelfSignedCertificateRead,
+		Delete: resourceNsxtPolicyCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicySelfSignedCertificateImport,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceNsxtPolicySelfSignedCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	algorithm := d.Get("algorithm").(string)
+	keySize := int64(d.Get("key_size").(int))
+	isCa := d.Get("is_ca").(bool)
+	daysValid := int64(d.Get("days_valid").(int))
+
+	obj := model.TlsCsrWithDaysValid{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Algorithm:   &algorithm,
+		KeySize:     &keySize,
+		IsCa:        &isCa,
+		Subject:     getCertificateSubjectFromSchema(d),
+		DaysValid:   &daysValid,
+	}
+
+	// ID of the certificate is assigned by NSX
+	log.Printf("[INFO] Creating self-signed Certificate %s", displayName)
+	client := infra.NewCsrsClient(connector)
+	cert, err := client.Selfsign0(obj)
+	if err != nil {
+		return handleCreateError("SelfSignedCertificate", displayName, err)
+	}
+
+	d.SetId(*cert.Id)
+	d.Set("nsx_id", *cert.Id)
+
+	return resourceNsxtPolicySelfSignedCertificateRead(d, m)
+}
+
+func resourceNsxtPolicySelfSignedCertificateRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SelfSignedCertificate ID")
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	obj, err := client.Get(id, &details)
+	if err != nil {
+		return handleReadError(d, "SelfSignedCertificate", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("pem_encoded", obj.PemEncoded)
+	setCertificateDetailsInSchema(d, obj.Details)
+
+	return nil
+}
+
+func resourceNsxtPolicySelfSignedCertificateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	rd, err := nsxtPolicyPathResourceImporter(d, m)
+	if err != nil {
+		return rd, err
+	}
+
+	// Key and subject settings can only be derived from the generated certificate
+	connector := getPolicyConnector(m)
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	obj, err := client.Get(d.Id(), &details)
+	if err != nil {
+		return rd, err
+	}
+	if len(obj.Details) > 0 {
+		setSelfSignedCertificateKeyInSchema(d, obj.Details[0])
+	}
+
+	return rd, nil
+}
@@ -0,0 +1,66 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySelfSignedCertificate_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_self_signed_certificate.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySelfSignedCertificateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCertificateExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "days_valid", "365"),
+					resource.TestCheckResourceAttr(testResourceName, "subject_cn", "test.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "issuer_cn", "test.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pem_encoded"),
+					resource.TestCheckResourceAttrSet(testResourceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySelfSignedCertificateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_self_signed_certificate" "test" {
+  display_name = "%s"
+  days_valid   = 365
+
+  subject {
+    common_name  = "test.example.com"
+    organization = "Acme Co"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
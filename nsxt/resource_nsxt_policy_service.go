@@ -702,11 +702,5 @@ func resourceNsxtPolicyServiceDelete(d *schema.ResourceData, m interface{}) erro
 		return client.Delete(id)
 	}
 
-	err := doDelete()
-
-	if err != nil {
-		return handleDeleteError("Service", id, err)
-	}
-
-	return nil
+	return policyDeleteWithReferrersCheck(d, m, "Service", doDelete)
 }
@@ -48,7 +48,7 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 		Update: resourceNsxtPolicyTier0GatewayUpdate,
 		Delete: resourceNsxtPolicyTier0GatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1038,6 +1038,13 @@ func resourceNsxtPolicyTier0GatewayUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining Tier0 ID")
 	}
 
+	// Locale services are read and patched as part of gateway configuration, while
+	// child resources (such as gateway interfaces) might modify them concurrently
+	gwPath := fmt.Sprintf("%s/tier-0s/%s", getPolicyInfraPath(getSessionContext(d, m)), id)
+	lockPaths := getPolicyGatewayLocaleServiceLockPaths(d, gwPath)
+	lockPolicyParents(lockPaths)
+	defer unlockPolicyParents(lockPaths)
+
 	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
 	if err != nil {
 		return handleUpdateError("Tier0", id, err)
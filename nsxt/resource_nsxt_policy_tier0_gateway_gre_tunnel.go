@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -392,18 +393,18 @@ func resourceNsxtPolicyTier0GatewayGRETunnelImport(d *schema.ResourceData, m int
 	// Path should be like /infra/tier-0s/test/locale-services/default/tunnels/test
 	importID := d.Id()
 	err := fmt.Errorf("expected GRE Tunnel path, got %s", importID)
-	s := strings.Split(importID, "/")
-	if len(s) < 8 {
-		return []*schema.ResourceData{d}, err
+	rd, pathErr := nsxtPolicyPathResourceImporterHelper(d, m)
+	if errors.Is(pathErr, ErrNotAPolicyPath) {
+		return rd, err
+	} else if pathErr != nil {
+		return rd, pathErr
 	}
 
-	d.SetId(s[len(s)-1])
-
-	s = strings.Split(importID, "/tunnels/")
+	s := strings.Split(importID, "/tunnels/")
 	if len(s) != 2 {
-		return []*schema.ResourceData{d}, err
+		return rd, err
 	}
 	d.Set("locale_service_path", s[0])
 
-	return []*schema.ResourceData{d}, nil
+	return rd, nil
 }
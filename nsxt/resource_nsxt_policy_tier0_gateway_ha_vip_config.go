@@ -150,6 +150,11 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigCreate(d *schema.ResourceData, m i
 
 	id := newUUID()
 
+	// HA VIP config is part of locale service, which may be modified concurrently
+	lockPath := getPolicyTier0LocaleServiceLockPath(getSessionContext(d, m), tier0ID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	var err error
 	if isPolicyGlobalManager(m) {
 		// Use patch to only update the relevant fields
@@ -275,6 +280,11 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigUpdate(d *schema.ResourceData, m i
 		HaVipConfigs: haVipConfigs,
 	}
 
+	// HA VIP config is part of locale service, which may be modified concurrently
+	lockPath := getPolicyTier0LocaleServiceLockPath(getSessionContext(d, m), tier0ID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	var err error
 	if isPolicyGlobalManager(m) {
 		// Use patch to only update the relevant fields
@@ -328,6 +338,10 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigDelete(d *schema.ResourceData, m i
 		return err
 	}
 
+	lockPath := getPolicyTier0LocaleServiceLockPath(getSessionContext(d, m), tier0ID, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
@@ -339,13 +353,23 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigDelete(d *schema.ResourceData, m i
 
 func resourceNsxtPolicyTier0GatewayHAVipConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
-	s := strings.Split(importID, "/")
-	if len(s) != 2 {
-		return nil, fmt.Errorf("Please provide <gateway-id>/<locale-service-id> as an input")
+	var tier0ID, localeServiceID string
+	if isPolicyPath(importID) {
+		// Locale service path, such as /infra/tier-0s/gw1/locale-services/default
+		var isT0 bool
+		var err error
+		isT0, tier0ID, localeServiceID, err = parseLocaleServicePolicyPath(importID)
+		if err != nil || !isT0 {
+			return nil, fmt.Errorf("Tier0 Gateway Locale Service path expected, got %s", importID)
+		}
+	} else {
+		s := strings.Split(importID, "/")
+		if len(s) != 2 {
+			return nil, fmt.Errorf("Please provide <gateway-id>/<locale-service-id> or locale service policy path as an input")
+		}
+		tier0ID = s[0]
+		localeServiceID = s[1]
 	}
-
-	tier0ID := s[0]
-	localeServiceID := s[1]
 	connector := getPolicyConnector(m)
 	if isPolicyGlobalManager(m) {
 		client := gm_tier0s.NewLocaleServicesClient(connector)
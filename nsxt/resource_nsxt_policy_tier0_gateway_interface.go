@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -333,6 +334,10 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 		return handleCreateError("Tier0 Interface", id, err)
 	}
 
+	lockPath := getPolicyLocaleServiceLockPath(tier0Path, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating Tier0 interface with ID %s", id)
 	if isPolicyGlobalManager(m) {
@@ -504,6 +509,10 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		return handleUpdateError("Tier0 Interface", id, err)
 	}
 
+	lockPath := getPolicyLocaleServiceLockPath(tier0Path, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.Tier0InterfaceBindingType(), gm_model.Tier0InterfaceBindingType())
 		if err1 != nil {
@@ -532,6 +541,11 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 	if id == "" || tier0ID == "" || localeServiceID == "" {
 		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
+
+	lockPath := getPolicyLocaleServiceLockPath(tier0Path, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	var err error
 	if isPolicyGlobalManager(m) {
 		client := gm_locale_services.NewInterfacesClient(connector)
@@ -549,6 +563,19 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 
 func resourceNsxtPolicyTier0GatewayInterfaceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err == nil {
+		gwPath, err := getAncestorPathFromResourcePath(importID, "tier-0s")
+		if err != nil {
+			return nil, err
+		}
+		d.Set("gateway_path", gwPath)
+		d.Set("locale_service_id", getResourceIDFromResourcePath(importID, "locale-services"))
+		return rd, nil
+	} else if !errors.Is(err, ErrNotAPolicyPath) {
+		return rd, err
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
 		return nil, fmt.Errorf("Please provide <gateway-id>/<locale-service-id>/<interface-id> as an input")
@@ -0,0 +1,388 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var interVrfRoutingAddressFamilyValues = []string{
+	model.BgpRouteLeaking_ADDRESS_FAMILY_IPV4,
+	model.BgpRouteLeaking_ADDRESS_FAMILY_IPV6,
+}
+
+var interVrfRoutingAdvRuleActionValues = []string{
+	model.PolicyRouteAdvertisementRule_ACTION_PERMIT,
+	model.PolicyRouteAdvertisementRule_ACTION_DENY,
+}
+
+var interVrfRoutingAdvRuleOperatorValues = []string{
+	model.PolicyRouteAdvertisementRule_PREFIX_OPERATOR_GE,
+	model.PolicyRouteAdvertisementRule_PREFIX_OPERATOR_EQ,
+}
+
+var interVrfRoutingAdvRuleTypeValues = []string{
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_STATIC,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_CONNECTED,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_NAT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_DNS_FORWARDER_IP,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_IPSEC_LOCAL_ENDPOINT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_STATIC,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_CONNECTED,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_LB_SNAT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_LB_VIP,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_NAT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_DNS_FORWARDER_IP,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_IPSEC_LOCAL_ENDPOINT,
+}
+
+func resourceNsxtPolicyTier0InterVrfRouting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTier0InterVrfRoutingCreate,
+		Read:   resourceNsxtPolicyTier0InterVrfRoutingRead,
+		Update: resourceNsxtPolicyTier0InterVrfRoutingUpdate,
+		Delete: resourceNsxtPolicyTier0InterVrfRoutingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyTier0GatewayImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for Tier0 or VRF gateway"),
+			"target_path":  getPolicyPathSchema(true, false, "Policy path of Tier0 or VRF gateway that belongs to the same parent Tier0"),
+			"bgp_route_leaking": {
+				Type:        schema.TypeList,
+				Description: "BGP route leaking configuration per address family",
+				Optional:    true,
+				MaxItems:    2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_family": {
+							Type:         schema.TypeString,
+							Description:  "Address family type",
+							Optional:     true,
+							Default:      model.BgpRouteLeaking_ADDRESS_FAMILY_IPV4,
+							ValidateFunc: validation.StringInSlice(interVrfRoutingAddressFamilyValues, false),
+						},
+						"in_filter": {
+							Type:        schema.TypeList,
+							Description: "Route map paths to filter imported routes",
+							Optional:    true,
+							Elem:        getPolicyPathSchemaSimple(),
+						},
+						"out_filter": {
+							Type:        schema.TypeList,
+							Description: "Route map paths to filter exported routes",
+							Optional:    true,
+							Elem:        getPolicyPathSchemaSimple(),
+						},
+					},
+				},
+			},
+			"static_route_advertisement": {
+				Type:        schema.TypeList,
+				Description: "Static route advertisement configuration",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advertisement_rule": {
+							Type:        schema.TypeList,
+							Description: "Route advertisement rules",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of this rule",
+										Required:    true,
+									},
+									"action": {
+										Type:         schema.TypeString,
+										Description:  "Action to advertise filtered routes",
+										Optional:     true,
+										Default:      model.PolicyRouteAdvertisementRule_ACTION_PERMIT,
+										ValidateFunc: validation.StringInSlice(interVrfRoutingAdvRuleActionValues, false),
+									},
+									"prefix_operator": {
+										Type:         schema.TypeString,
+										Description:  "Prefix operator to apply on networks",
+										Optional:     true,
+										Default:      model.PolicyRouteAdvertisementRule_PREFIX_OPERATOR_GE,
+										ValidateFunc: validation.StringInSlice(interVrfRoutingAdvRuleOperatorValues, false),
+									},
+									"route_advertisement_types": {
+										Type:        schema.TypeSet,
+										Description: "Types of routes to advertise",
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(interVrfRoutingAdvRuleTypeValues, false),
+										},
+									},
+									"subnets": {
+										Type:        schema.TypeSet,
+										Description: "List of network CIDRs to be routed",
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateCidr(),
+										},
+									},
+								},
+							},
+						},
+						"in_filter_prefix_list": {
+							Type:        schema.TypeList,
+							Description: "Paths of ordered prefix lists, evaluation stops after first match",
+							Optional:    true,
+							Elem:        getPolicyPathSchemaSimple(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyTier0InterVrfRoutingExists(gwID string, id string, connector client.Connector) (bool, error) {
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	_, err := client.Get(gwID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyInterVrfBgpRouteLeakingFromSchema(d *schema.ResourceData) []model.BgpRouteLeaking {
+	var result []model.BgpRouteLeaking
+	for _, item := range d.Get("bgp_route_leaking").([]interface{}) {
+		data := item.(map[string]interface{})
+		addressFamily := data["address_family"].(string)
+		result = append(result, model.BgpRouteLeaking{
+			AddressFamily: &addressFamily,
+			InFilter:      interface2StringList(data["in_filter"].([]interface{})),
+			OutFilter:     interface2StringList(data["out_filter"].([]interface{})),
+		})
+	}
+
+	return result
+}
+
+func setPolicyInterVrfBgpRouteLeakingInSchema(d *schema.ResourceData, leakingList []model.BgpRouteLeaking) error {
+	var result []interface{}
+	for _, leaking := range leakingList {
+		elem := make(map[string]interface{})
+		elem["address_family"] = leaking.AddressFamily
+		elem["in_filter"] = leaking.InFilter
+		elem["out_filter"] = leaking.OutFilter
+		result = append(result, elem)
+	}
+
+	return d.Set("bgp_route_leaking", result)
+}
+
+func getPolicyInterVrfStaticRouteAdvertisementFromSchema(d *schema.ResourceData) *model.PolicyStaticRouteAdvertisement {
+	configs := d.Get("static_route_advertisement").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	data := configs[0].(map[string]interface{})
+	var rules []model.PolicyRouteAdvertisementRule
+	for _, item := range data["advertisement_rule"].([]interface{}) {
+		ruleData := item.(map[string]interface{})
+		name := ruleData["name"].(string)
+		action := ruleData["action"].(string)
+		prefixOperator := ruleData["prefix_operator"].(string)
+		rules = append(rules, model.PolicyRouteAdvertisementRule{
+			Name:                    &name,
+			Action:                  &action,
+			PrefixOperator:          &prefixOperator,
+			RouteAdvertisementTypes: interface2StringList(ruleData["route_advertisement_types"].(*schema.Set).List()),
+			Subnets:                 interface2StringList(ruleData["subnets"].(*schema.Set).List()),
+		})
+	}
+
+	return &model.PolicyStaticRouteAdvertisement{
+		AdvertisementRules: rules,
+		InFilterPrefixList: interface2StringList(data["in_filter_prefix_list"].([]interface{})),
+	}
+}
+
+func setPolicyInterVrfStaticRouteAdvertisementInSchema(d *schema.ResourceData, config *model.PolicyStaticRouteAdvertisement) error {
+	var result []interface{}
+	if config != nil {
+		var rules []interface{}
+		for _, rule := range config.AdvertisementRules {
+			ruleElem := make(map[string]interface{})
+			ruleElem["name"] = rule.Name
+			ruleElem["action"] = rule.Action
+			ruleElem["prefix_operator"] = rule.PrefixOperator
+			ruleElem["route_advertisement_types"] = rule.RouteAdvertisementTypes
+			ruleElem["subnets"] = rule.Subnets
+			rules = append(rules, ruleElem)
+		}
+
+		elem := make(map[string]interface{})
+		elem["advertisement_rule"] = rules
+		elem["in_filter_prefix_list"] = config.InFilterPrefixList
+		result = append(result, elem)
+	}
+
+	return d.Set("static_route_advertisement", result)
+}
+
+func resourceNsxtPolicyTier0InterVrfRoutingPatch(gwID string, id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	targetPath := d.Get("target_path").(string)
+
+	obj := model.PolicyInterVrfRoutingConfig{
+		DisplayName:              &displayName,
+		Description:              &description,
+		Tags:                     tags,
+		TargetPath:               &targetPath,
+		BgpRouteLeaking:          getPolicyInterVrfBgpRouteLeakingFromSchema(d),
+		StaticRouteAdvertisement: getPolicyInterVrfStaticRouteAdvertisementFromSchema(d),
+	}
+
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	return client.Patch(gwID, id, obj)
+}
+
+func resourceNsxtPolicyTier0InterVrfRoutingCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	if nsxVersionLower("4.1.0") {
+		return fmt.Errorf("Inter VRF routing is supported with NSX 4.1.0 and above")
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 {
+		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyTier0InterVrfRoutingExists(gwID, id, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Inter VRF Routing with ID '%s' already exists on Tier0 Gateway %s", id, gwID)
+		}
+	}
+
+	log.Printf("[INFO] Creating Inter VRF Routing with ID %s", id)
+	err := resourceNsxtPolicyTier0InterVrfRoutingPatch(gwID, id, d, connector)
+	if err != nil {
+		return handleCreateError("Inter VRF Routing", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTier0InterVrfRoutingRead(d, m)
+}
+
+func resourceNsxtPolicyTier0InterVrfRoutingRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Inter VRF Routing ID")
+	}
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 {
+		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+	}
+
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	obj, err := client.Get(gwID, id)
+	if err != nil {
+		return handleReadError(d, "Inter VRF Routing", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("target_path", obj.TargetPath)
+
+	err = setPolicyInterVrfBgpRouteLeakingInSchema(d, obj.BgpRouteLeaking)
+	if err != nil {
+		return handleReadError(d, "Inter VRF Routing", id, err)
+	}
+
+	err = setPolicyInterVrfStaticRouteAdvertisementInSchema(d, obj.StaticRouteAdvertisement)
+	if err != nil {
+		return handleReadError(d, "Inter VRF Routing", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTier0InterVrfRoutingUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Inter VRF Routing ID")
+	}
+	gwPath := d.Get("gateway_path").(string)
+	_, gwID := parseGatewayPolicyPath(gwPath)
+
+	log.Printf("[INFO] Updating Inter VRF Routing with ID %s", id)
+	err := resourceNsxtPolicyTier0InterVrfRoutingPatch(gwID, id, d, connector)
+	if err != nil {
+		return handleUpdateError("Inter VRF Routing", id, err)
+	}
+
+	return resourceNsxtPolicyTier0InterVrfRoutingRead(d, m)
+}
+
+func resourceNsxtPolicyTier0InterVrfRoutingDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Inter VRF Routing ID")
+	}
+	gwPath := d.Get("gateway_path").(string)
+	_, gwID := parseGatewayPolicyPath(gwPath)
+
+	connector := getPolicyConnector(m)
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	err := client.Delete(gwID, id)
+	if err != nil {
+		return handleDeleteError("Inter VRF Routing", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTier0InterVrfRouting_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tier0_inter_vrf_routing.test"
+	displayName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "4.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0InterVrfRoutingCheckDestroy(state, displayName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0InterVrfRoutingCreateTemplate(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterVrfRoutingExists(displayName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "target_path"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.address_family", "IPV4"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.out_filter.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.0.advertisement_rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.0.advertisement_rule.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.0.in_filter_prefix_list.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0InterVrfRoutingMinimalistic(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterVrfRoutingExists(displayName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTier0InterVrfRouting_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_inter_vrf_routing.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "4.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0InterVrfRoutingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0InterVrfRoutingMinimalistic(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyGetGatewayImporterIDGenerator(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier0InterVrfRoutingExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Inter VRF Routing resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Inter VRF Routing resource ID not set in resources")
+		}
+		gwPath := rs.Primary.Attributes["gateway_path"]
+		_, gwID := parseGatewayPolicyPath(gwPath)
+
+		exists, err := resourceNsxtPolicyTier0InterVrfRoutingExists(gwID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Inter VRF Routing %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTier0InterVrfRoutingCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tier0_inter_vrf_routing" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		gwPath := rs.Primary.Attributes["gateway_path"]
+		_, gwID := parseGatewayPolicyPath(gwPath)
+
+		exists, err := resourceNsxtPolicyTier0InterVrfRoutingExists(gwID, resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Inter VRF Routing %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTier0InterVrfRoutingDepsTemplate() string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + `
+resource "nsxt_policy_tier0_gateway" "parent" {
+  display_name      = "inter-vrf-parent"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_tier0_gateway" "shared" {
+  display_name      = "inter-vrf-shared"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  vrf_config {
+    gateway_path = nsxt_policy_tier0_gateway.parent.path
+  }
+}
+
+resource "nsxt_policy_tier0_gateway" "tenant" {
+  display_name      = "inter-vrf-tenant"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  vrf_config {
+    gateway_path = nsxt_policy_tier0_gateway.parent.path
+  }
+}`
+}
+
+func testAccNsxtPolicyTier0InterVrfRoutingCreateTemplate(displayName string) string {
+	return testAccNsxtPolicyTier0InterVrfRoutingDepsTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_gateway_prefix_list" "test" {
+  display_name = "%s"
+  gateway_path = nsxt_policy_tier0_gateway.shared.path
+
+  prefix {
+    action  = "PERMIT"
+    network = "10.10.0.0/16"
+  }
+}
+
+resource "nsxt_policy_gateway_route_map" "test" {
+  display_name = "%s"
+  gateway_path = nsxt_policy_tier0_gateway.shared.path
+
+  entry {
+    action              = "PERMIT"
+    prefix_list_matches = [nsxt_policy_gateway_prefix_list.test.path]
+  }
+}
+
+resource "nsxt_policy_tier0_inter_vrf_routing" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+  gateway_path = nsxt_policy_tier0_gateway.shared.path
+  target_path  = nsxt_policy_tier0_gateway.tenant.path
+
+  bgp_route_leaking {
+    address_family = "IPV4"
+    out_filter     = [nsxt_policy_gateway_route_map.test.path]
+  }
+
+  static_route_advertisement {
+    advertisement_rule {
+      name                      = "rule1"
+      action                    = "PERMIT"
+      subnets                   = ["10.10.0.0/16"]
+      route_advertisement_types = ["TIER0_CONNECTED"]
+    }
+    in_filter_prefix_list = [nsxt_policy_gateway_prefix_list.test.path]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, displayName, displayName)
+}
+
+func testAccNsxtPolicyTier0InterVrfRoutingMinimalistic(displayName string) string {
+	return testAccNsxtPolicyTier0InterVrfRoutingDepsTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_tier0_inter_vrf_routing" "test" {
+  display_name = "%s"
+  gateway_path = nsxt_policy_tier0_gateway.shared.path
+  target_path  = nsxt_policy_tier0_gateway.tenant.path
+}`, displayName)
+}
@@ -651,6 +651,13 @@ func resourceNsxtPolicyTier1GatewayUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining Tier1 id")
 	}
 
+	// Locale services are read and patched as part of gateway configuration, while
+	// child resources (such as gateway interfaces) might modify them concurrently
+	gwPath := fmt.Sprintf("%s/tier-1s/%s", getPolicyInfraPath(getSessionContext(d, m)), id)
+	lockPaths := getPolicyGatewayLocaleServiceLockPaths(d, gwPath)
+	lockPolicyParents(lockPaths)
+	defer unlockPolicyParents(lockPaths)
+
 	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
 	if err != nil {
 		return err
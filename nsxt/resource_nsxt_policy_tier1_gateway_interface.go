@@ -160,6 +160,10 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating tier1 interface with ID %s", id)
+	lockPath := getPolicyLocaleServiceLockPath(tier1Path, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	client := localeservices.NewInterfacesClient(getSessionContext(d, m), connector)
 	err := client.Patch(tier1ID, localeServiceID, id, obj)
 
@@ -284,6 +288,10 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		obj.UrpfMode = &urpfMode
 	}
 	var err error
+	lockPath := getPolicyLocaleServiceLockPath(tier1Path, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	client := localeservices.NewInterfacesClient(getSessionContext(d, m), connector)
 	_, err = client.Update(tier1ID, localeServiceID, id, obj)
 	if err != nil {
@@ -304,6 +312,10 @@ func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m int
 		return fmt.Errorf("Error obtaining Tier1 id or Locale Service id")
 	}
 
+	lockPath := getPolicyLocaleServiceLockPath(tier1Path, localeServiceID)
+	lockPolicyParent(lockPath)
+	defer unlockPolicyParent(lockPath)
+
 	var err error
 	client := localeservices.NewInterfacesClient(getSessionContext(d, m), connector)
 	err = client.Delete(tier1ID, localeServiceID, id)
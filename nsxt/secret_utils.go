@@ -0,0 +1,128 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Secrets are never stored in state in cleartext. Instead, state holds a keyed hash
+// of the secret, in form sha256:<salt>:<hmac>, where salt is generated randomly for
+// each hash. This is sufficient to detect change of the secret in configuration, while
+// identical secrets do not produce identical hashes. Cleartext value is taken directly
+// from configuration whenever it needs to be sent to NSX.
+const secretHashPrefix = "sha256:"
+const secretHashSaltLength = 16
+
+func computeSecretHash(salt []byte, value string) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(value))
+	return secretHashPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseSecretHash(hash string) ([]byte, bool) {
+	if !strings.HasPrefix(hash, secretHashPrefix) {
+		return nil, false
+	}
+	parts := strings.Split(strings.TrimPrefix(hash, secretHashPrefix), ":")
+	if len(parts) != 2 {
+		return nil, false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil || len(salt) == 0 {
+		return nil, false
+	}
+	if _, err := hex.DecodeString(parts[1]); err != nil {
+		return nil, false
+	}
+	return salt, true
+}
+
+func isSecretHash(value string) bool {
+	_, ok := parseSecretHash(value)
+	return ok
+}
+
+// getSecretHash returns hash of the secret with newly generated salt. Values that are
+// already hashed are returned as is, so that hash kept in state does not change on read.
+func getSecretHash(value string) string {
+	if value == "" || isSecretHash(value) {
+		return value
+	}
+	salt := make([]byte, secretHashSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		panic(err)
+	}
+	return computeSecretHash(salt, value)
+}
+
+// verifySecretHash checks whether hash was computed from given cleartext value
+func verifySecretHash(hash string, value string) bool {
+	salt, ok := parseSecretHash(hash)
+	if !ok {
+		return false
+	}
+	return hmac.Equal([]byte(hash), []byte(computeSecretHash(salt, value)))
+}
+
+// secretDiffSuppressFunc suppresses diff between hash kept in state and cleartext value
+// in configuration, as long as the hash matches the value
+func secretDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	return verifySecretHash(old, new)
+}
+
+func getSecretVersionSchema(secretName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Change this value in order to rotate " + secretName + " on NSX even if its value in configuration did not change",
+		Optional:    true,
+	}
+}
+
+// getSecretFromConfig returns cleartext secret at given path within resource configuration,
+// for example ("ldap_server", 0, "password"). Elements of path are attribute names and list
+// indexes. Empty string is returned if the secret is not configured.
+func getSecretFromConfig(d *schema.ResourceData, path ...interface{}) string {
+	value := d.GetRawConfig()
+	for _, step := range path {
+		if value.IsNull() || !value.IsKnown() {
+			return ""
+		}
+		switch s := step.(type) {
+		case string:
+			if !value.Type().IsObjectType() || !value.Type().HasAttribute(s) {
+				return ""
+			}
+			value = value.GetAttr(s)
+		case int:
+			if !value.CanIterateElements() || value.LengthInt() <= s {
+				return ""
+			}
+			value = value.Index(cty.NumberIntVal(int64(s)))
+		default:
+			return ""
+		}
+	}
+
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
+
+// setSecretHashInSchema replaces secret value in state with its hash. This takes care of
+// cleartext values stored in state by earlier versions of the provider.
+func setSecretHashInSchema(d *schema.ResourceData, key string) {
+	d.Set(key, getSecretHash(d.Get(key).(string)))
+}
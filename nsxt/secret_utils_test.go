@@ -0,0 +1,198 @@
+/* Copyright © 2024 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccCheckResourceSecretHash(resourceName string, key string, value string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(resourceName, key, func(hash string) error {
+		if !verifySecretHash(hash, value) {
+			return fmt.Errorf("%s.%s does not hold hash of the configured secret", resourceName, key)
+		}
+		return nil
+	})
+}
+
+func TestGetSecretHash(t *testing.T) {
+	hash := getSecretHash("s3cret")
+	if !isSecretHash(hash) {
+		t.Fatalf("expected %s to be a secret hash", hash)
+	}
+	if !verifySecretHash(hash, "s3cret") {
+		t.Errorf("expected hash to match the secret")
+	}
+	if verifySecretHash(hash, "s3cret!") {
+		t.Errorf("expected hash not to match a different secret")
+	}
+
+	// Salt is random, hence same secret produces different hashes
+	other := getSecretHash("s3cret")
+	if other == hash {
+		t.Errorf("expected different hashes for same secret, got %s twice", hash)
+	}
+	if !verifySecretHash(other, "s3cret") {
+		t.Errorf("expected second hash to match the secret")
+	}
+
+	// Existing hash and empty value are kept as is
+	if getSecretHash(hash) != hash {
+		t.Errorf("expected existing hash to be kept")
+	}
+	if getSecretHash("") != "" {
+		t.Errorf("expected empty value to be kept")
+	}
+}
+
+func TestIsSecretHash(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "", expected: false},
+		{value: "s3cret", expected: false},
+		{value: "sha256:", expected: false},
+		{value: "sha256:abcd", expected: false},
+		{value: "sha256:xyz:abcd", expected: false},
+		{value: "sha256::abcd", expected: false},
+		{value: "sha256:abcd:xyz", expected: false},
+		{value: "sha256:abcd:abcd", expected: true},
+	}
+
+	for _, test := range tests {
+		if result := isSecretHash(test.value); result != test.expected {
+			t.Errorf("isSecretHash(%q): expected %v, got %v", test.value, test.expected, result)
+		}
+	}
+
+	if verifySecretHash("s3cret", "s3cret") {
+		t.Errorf("expected cleartext value not to verify as hash")
+	}
+}
+
+func TestSecretDiffSuppressFunc(t *testing.T) {
+	hash := getSecretHash("s3cret")
+	tests := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{old: "", new: "", expected: true},
+		{old: hash, new: "s3cret", expected: true},
+		{old: hash, new: hash, expected: true},
+		{old: hash, new: "other", expected: false},
+		{old: hash, new: "", expected: false},
+		{old: "", new: "s3cret", expected: false},
+		{old: "s3cret", new: "other", expected: false},
+	}
+
+	for _, test := range tests {
+		if result := secretDiffSuppressFunc("password", test.old, test.new, nil); result != test.expected {
+			t.Errorf("secretDiffSuppressFunc(%q, %q): expected %v, got %v", test.old, test.new, test.expected, result)
+		}
+	}
+}
+
+func TestGetSecretFromConfig(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"server": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"password": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"credential": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"password": {
+									Type:      schema.TypeString,
+									Optional:  true,
+									Sensitive: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Raw configuration is only available when provided by Terraform core, hence it is
+	// set in state explicitly, matching the schema above
+	credentialType := cty.Object(map[string]cty.Type{"password": cty.String})
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"password":    cty.StringVal("top"),
+		"description": cty.NullVal(cty.String),
+		"server": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"url":        cty.StringVal("ldap://first"),
+				"password":   cty.StringVal("first"),
+				"credential": cty.ListValEmpty(credentialType),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"url":      cty.StringVal("ldap://second"),
+				"password": cty.StringVal("second"),
+				"credential": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"password": cty.StringVal("nested")}),
+				}),
+			}),
+		}),
+	})
+	r := &schema.Resource{Schema: testSchema}
+	d := r.Data(&terraform.InstanceState{ID: "test", RawConfig: rawConfig})
+
+	tests := []struct {
+		name     string
+		path     []interface{}
+		expected string
+	}{
+		{name: "top level", path: []interface{}{"password"}, expected: "top"},
+		{name: "not configured", path: []interface{}{"description"}, expected: ""},
+		{name: "unknown attribute", path: []interface{}{"unknown"}, expected: ""},
+		{name: "first list element", path: []interface{}{"server", 0, "password"}, expected: "first"},
+		{name: "second list element", path: []interface{}{"server", 1, "password"}, expected: "second"},
+		{name: "nested block", path: []interface{}{"server", 1, "credential", 0, "password"}, expected: "nested"},
+		{name: "nested block not configured", path: []interface{}{"server", 0, "credential", 0, "password"}, expected: ""},
+		{name: "index out of range", path: []interface{}{"server", 2, "password"}, expected: ""},
+		{name: "index on string", path: []interface{}{"password", 0}, expected: ""},
+		{name: "attribute on list", path: []interface{}{"server", "password"}, expected: ""},
+		{name: "list is not a string", path: []interface{}{"server"}, expected: ""},
+		{name: "invalid step", path: []interface{}{"server", 0.5}, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := getSecretFromConfig(d, test.path...); result != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
@@ -307,6 +307,15 @@ func getPolicyCommonSegmentSchema(vlanRequired bool, isFixed bool) map[string]*s
 			Optional:    true,
 		},
 		"dhcp_config_path": getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for subnets configured on this segment"),
+		"metadata_proxy_paths": {
+			Type:        schema.TypeList,
+			Description: "Policy paths to metadata proxy configurations",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validatePolicyPath(),
+			},
+		},
 		"transport_zone_path": {
 			Type:         schema.TypeString,
 			Description:  "Policy path to the transport zone",
@@ -694,6 +703,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 	tzPath := d.Get("transport_zone_path").(string)
 	replicationMode := d.Get("replication_mode").(string)
 	dhcpConfigPath := d.Get("dhcp_config_path").(string)
+	metadataProxyPaths := interface2StringList(d.Get("metadata_proxy_paths").([]interface{}))
 	revision := int64(d.Get("revision").(int))
 	resourceType := "Segment"
 
@@ -718,6 +728,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 	if tzPath != "" {
 		obj.TransportZonePath = &tzPath
 	}
+	obj.MetadataProxyPaths = metadataProxyPaths
 	if nsxVersionHigherOrEqual("3.0.0") {
 		obj.ReplicationMode = &replicationMode
 		if dhcpConfigPath != "" {
@@ -1349,6 +1360,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		d.Set("connectivity_path", obj.ConnectivityPath)
 	}
 	d.Set("dhcp_config_path", obj.DhcpConfigPath)
+	d.Set("metadata_proxy_paths", obj.MetadataProxyPaths)
 	d.Set("domain_name", obj.DomainName)
 	d.Set("transport_zone_path", obj.TransportZonePath)
 
@@ -1578,9 +1590,12 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 	}
 
 	log.Printf("[DEBUG] Using H-API to delete segment with ID %s", id)
-	err := policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+	doDelete := func() error {
+		return policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+	}
+	err := policyDeleteWithReferrersCheck(d, m, "Segment", doDelete)
 	if err != nil {
-		return handleDeleteError("Segment", id, err)
+		return err
 	}
 	log.Printf("[DEBUG] Success deleting Segment with ID %s", id)
 
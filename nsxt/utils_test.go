@@ -229,6 +229,10 @@ func getTestManagerClusterNode() string {
 	return os.Getenv("NSXT_TEST_MANAGER_CLUSTER_NODE")
 }
 
+func getTestLicenseKey() string {
+	return os.Getenv("NSXT_TEST_LICENSE_KEY")
+}
+
 func testAccEnvDefined(t *testing.T, envVar string) {
 	if len(os.Getenv(envVar)) == 0 {
 		t.Skipf("This test requires %s environment variable to be set", envVar)
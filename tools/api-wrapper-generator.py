@@ -149,7 +149,8 @@ FUNC_DEF_CALLBACK = {
     'Patch': api_func_def_setup,
     'Update': api_func_def_setup,
     'Delete': api_func_def_setup,
-    'List': list_func_def_setup
+    'List': list_func_def_setup,
+    'Publish': api_func_def_setup
 }
 
 FUNC_CALL_CALLBACK = {
@@ -159,7 +160,8 @@ FUNC_CALL_CALLBACK = {
     'Patch': patch_func_call_setup,
     'Update': patch_func_call_setup,
     'Delete': api_func_call_setup,
-    'List': api_func_call_setup
+    'List': api_func_call_setup,
+    'Publish': api_func_call_setup
 }
 
 atexit.register(cleanup)